@@ -0,0 +1,42 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const balanceBasePath = "shopify_payments/balance"
+
+// BalanceService is an interface for interfacing with the Shopify Payments
+// balance endpoint of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/balance
+type BalanceService interface {
+	Get(context.Context) ([]Balance, error)
+}
+
+// BalanceServiceOp handles communication with the balance related methods of
+// the Shopify API.
+type BalanceServiceOp struct {
+	client *Client
+}
+
+// Balance represents the current Shopify Payments balance in one currency
+type Balance struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// Represents the result from the balance.json endpoint
+type BalanceResource struct {
+	Balance []Balance `json:"balance"`
+}
+
+// Get the current balance, one entry per currency
+func (s *BalanceServiceOp) Get(ctx context.Context) ([]Balance, error) {
+	path := fmt.Sprintf("%s.json", balanceBasePath)
+	resource := new(BalanceResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.Balance, err
+}
@@ -0,0 +1,32 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestBalanceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/balance.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"balance": [{"amount": "53.99", "currency": "USD"}, {"amount": "10.00", "currency": "CAD"}]}`))
+
+	balance, err := client.Balance.Get(context.Background())
+	if err != nil {
+		t.Errorf("Balance.Get returned error: %v", err)
+	}
+
+	expected := []Balance{
+		{Amount: decimal.RequireFromString("53.99"), Currency: "USD"},
+		{Amount: decimal.RequireFromString("10.00"), Currency: "CAD"},
+	}
+	if !reflect.DeepEqual(balance, expected) {
+		t.Errorf("Balance.Get returned %+v, expected %+v", balance, expected)
+	}
+}
@@ -0,0 +1,383 @@
+package goshopify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+)
+
+const defaultBulkOperationPollInterval = 5 * time.Second
+
+// BulkOperationService is an interface for running bulk mutations through the
+// graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/api/usage/bulk-operations/imports
+type BulkOperationService interface {
+	RunMutation(context.Context, string, string) (*BulkOperation, error)
+	Get(context.Context, GID) (*BulkOperation, error)
+	Cancel(context.Context, GID) (*BulkOperation, error)
+	Wait(context.Context, GID, time.Duration) (*BulkOperation, error)
+	RunMutationWithItems(context.Context, string, interface{}, time.Duration) ([]BulkMutationResult, error)
+}
+
+// BulkOperationServiceOp handles communication with the bulk operation
+// related methods of the Shopify API.
+type BulkOperationServiceOp struct {
+	client *Client
+}
+
+// BulkOperationStatus is the status of a bulk operation
+type BulkOperationStatus string
+
+const (
+	BulkOperationStatusCreated   BulkOperationStatus = "CREATED"
+	BulkOperationStatusRunning   BulkOperationStatus = "RUNNING"
+	BulkOperationStatusCompleted BulkOperationStatus = "COMPLETED"
+	BulkOperationStatusCanceling BulkOperationStatus = "CANCELING"
+	BulkOperationStatusCanceled  BulkOperationStatus = "CANCELED"
+	BulkOperationStatusFailed    BulkOperationStatus = "FAILED"
+	BulkOperationStatusExpired   BulkOperationStatus = "EXPIRED"
+)
+
+// BulkOperationType is the type of a bulk operation
+type BulkOperationType string
+
+const (
+	BulkOperationTypeQuery    BulkOperationType = "QUERY"
+	BulkOperationTypeMutation BulkOperationType = "MUTATION"
+)
+
+// BulkOperation represents a Shopify bulk operation
+type BulkOperation struct {
+	Id             GID                 `json:"id"`
+	Type           BulkOperationType   `json:"type,omitempty"`
+	Status         BulkOperationStatus `json:"status,omitempty"`
+	ErrorCode      string              `json:"errorCode,omitempty"`
+	Query          string              `json:"query,omitempty"`
+	ObjectCount    uint64              `json:"objectCount,omitempty,string"`
+	FileSize       uint64              `json:"fileSize,omitempty,string"`
+	Url            string              `json:"url,omitempty"`
+	PartialDataUrl string              `json:"partialDataUrl,omitempty"`
+	CreatedAt      *time.Time          `json:"createdAt,omitempty"`
+	CompletedAt    *time.Time          `json:"completedAt,omitempty"`
+}
+
+// BulkMutationResult represents the result of a single input line of a bulk
+// mutation
+type BulkMutationResult struct {
+	// Index is the position of the input item in the slice passed to
+	// RunMutationWithItems
+	Index int
+
+	// Input is the item the result belongs to
+	Input interface{}
+
+	// Data is the payload of the mutation, e.g. the productCreate object
+	Data json.RawMessage
+
+	UserErrors []GraphQLUserError
+	Errors     []string
+}
+
+// Done reports whether the bulk operation has finished running
+func (b BulkOperation) Done() bool {
+	switch b.Status {
+	case BulkOperationStatusCompleted, BulkOperationStatusCanceled, BulkOperationStatusFailed, BulkOperationStatusExpired:
+		return true
+	}
+	return false
+}
+
+const bulkOperationFields = `
+  id
+  type
+  status
+  errorCode
+  query
+  objectCount
+  fileSize
+  url
+  partialDataUrl
+  createdAt
+  completedAt
+`
+
+const bulkOperationRunMutation = `mutation bulkOperationRunMutation($mutation: String!, $stagedUploadPath: String!) {
+  bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $stagedUploadPath) {
+    bulkOperation {` + bulkOperationFields + `}
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const bulkOperationCancelMutation = `mutation bulkOperationCancel($id: ID!) {
+  bulkOperationCancel(id: $id) {
+    bulkOperation {` + bulkOperationFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const bulkOperationQuery = `query bulkOperation($id: ID!) {
+  node(id: $id) {
+    ... on BulkOperation {` + bulkOperationFields + `}
+  }
+}`
+
+// RunMutation starts a bulk mutation using the variables previously uploaded
+// to the given staged upload path
+func (s *BulkOperationServiceOp) RunMutation(ctx context.Context, mutation, stagedUploadPath string) (*BulkOperation, error) {
+	vars := map[string]interface{}{
+		"mutation":         mutation,
+		"stagedUploadPath": stagedUploadPath,
+	}
+	resp := struct {
+		BulkOperationRunMutation struct {
+			BulkOperation *BulkOperation     `json:"bulkOperation"`
+			UserErrors    []GraphQLUserError `json:"userErrors"`
+		} `json:"bulkOperationRunMutation"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, bulkOperationRunMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.BulkOperationRunMutation.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if resp.BulkOperationRunMutation.BulkOperation == nil {
+		return nil, errors.New("bulk mutation was not started, no bulk operation returned")
+	}
+
+	return resp.BulkOperationRunMutation.BulkOperation, nil
+}
+
+// Get retrieves a bulk operation
+func (s *BulkOperationServiceOp) Get(ctx context.Context, id GID) (*BulkOperation, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		Node *BulkOperation `json:"node"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, bulkOperationQuery, vars, &resp)
+	return resp.Node, err
+}
+
+// Cancel starts the cancelation of a running bulk operation
+func (s *BulkOperationServiceOp) Cancel(ctx context.Context, id GID) (*BulkOperation, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		BulkOperationCancel struct {
+			BulkOperation *BulkOperation     `json:"bulkOperation"`
+			UserErrors    []GraphQLUserError `json:"userErrors"`
+		} `json:"bulkOperationCancel"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, bulkOperationCancelMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.BulkOperationCancel.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.BulkOperationCancel.BulkOperation, nil
+}
+
+// Wait polls a bulk operation every interval until it is done or the context
+// is canceled. An interval of 0 uses a default of 5 seconds.
+func (s *BulkOperationServiceOp) Wait(ctx context.Context, id GID, interval time.Duration) (*BulkOperation, error) {
+	if interval <= 0 {
+		interval = defaultBulkOperationPollInterval
+	}
+
+	for {
+		op, err := s.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if op == nil {
+			return nil, fmt.Errorf("bulk operation %s not found", id)
+		}
+
+		if op.Done() {
+			return op, nil
+		}
+
+		s.client.log.Debugf("bulk operation %s is %s, waiting %s", id, op.Status, interval)
+
+		select {
+		case <-ctx.Done():
+			return op, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// RunMutationWithItems runs a bulk mutation for every item of the given
+// slice. Each item must marshal to the variables of the mutation, e.g.
+// {"input": {...}}. The items are uploaded as a JSONL file, the mutation is
+// run and waited for, and the results are mapped back to their input items.
+// A failed, canceled or expired operation returns an error along with any
+// partial results.
+func (s *BulkOperationServiceOp) RunMutationWithItems(ctx context.Context, mutation string, items interface{}, pollInterval time.Duration) ([]BulkMutationResult, error) {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("items must be a slice, got %T", items)
+	}
+
+	inputs := make([]interface{}, v.Len())
+	var jsonl bytes.Buffer
+	for i := 0; i < v.Len(); i++ {
+		inputs[i] = v.Index(i).Interface()
+		line, err := json.Marshal(inputs[i])
+		if err != nil {
+			return nil, err
+		}
+		jsonl.Write(line)
+		jsonl.WriteByte('\n')
+	}
+
+	target, err := s.client.File.StagedUpload(ctx, StagedUploadInput{
+		Resource:   StagedUploadResourceBulkMutationVariables,
+		Filename:   "bulk_op_vars",
+		MimeType:   "text/jsonl",
+		HttpMethod: StagedUploadHttpMethodPost,
+	}, &jsonl)
+	if err != nil {
+		return nil, err
+	}
+
+	var stagedUploadPath string
+	for _, param := range target.Parameters {
+		if param.Name == "key" {
+			stagedUploadPath = param.Value
+		}
+	}
+	if stagedUploadPath == "" {
+		return nil, errors.New("staged upload target has no key parameter")
+	}
+
+	op, err := s.RunMutation(ctx, mutation, stagedUploadPath)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err = s.Wait(ctx, op.Id, pollInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	resultUrl := op.Url
+	if resultUrl == "" {
+		resultUrl = op.PartialDataUrl
+	}
+
+	var results []BulkMutationResult
+	if resultUrl != "" {
+		results, err = s.downloadResults(ctx, resultUrl, inputs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if op.Status != BulkOperationStatusCompleted {
+		return results, fmt.Errorf("bulk operation %s is %s: %s", op.Id, op.Status, op.ErrorCode)
+	}
+
+	return results, nil
+}
+
+// downloadResults fetches the JSONL result file of a bulk mutation and maps
+// every line back to its input item
+func (s *BulkOperationServiceOp) downloadResults(ctx context.Context, resultUrl string, inputs []interface{}) ([]BulkMutationResult, error) {
+	req, err := http.NewRequest("GET", resultUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := s.client.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, ResponseError{
+			Status:  resp.StatusCode,
+			Message: "could not download bulk operation results",
+		}
+	}
+
+	results := []BulkMutationResult{}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+
+	for lineNumber := 0; scanner.Scan(); lineNumber++ {
+		line := struct {
+			Data       map[string]json.RawMessage `json:"data"`
+			Errors     []graphQLError             `json:"errors"`
+			LineNumber *int                       `json:"__lineNumber"`
+		}{}
+
+		err := json.Unmarshal(scanner.Bytes(), &line)
+		if err != nil {
+			return nil, ResponseDecodingError{
+				Body:    scanner.Bytes(),
+				Message: err.Error(),
+			}
+		}
+
+		result := BulkMutationResult{Index: lineNumber}
+		if line.LineNumber != nil {
+			result.Index = *line.LineNumber
+		}
+
+		if result.Index >= 0 && result.Index < len(inputs) {
+			result.Input = inputs[result.Index]
+		}
+
+		// the payload is the only field of data, named after the mutation
+		for _, payload := range line.Data {
+			result.Data = payload
+			userErrors := struct {
+				UserErrors []GraphQLUserError `json:"userErrors"`
+			}{}
+			_ = json.Unmarshal(payload, &userErrors)
+			result.UserErrors = userErrors.UserErrors
+		}
+
+		for _, lineErr := range line.Errors {
+			result.Errors = append(result.Errors, lineErr.Message)
+		}
+
+		results = append(results, result)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// result lines are not guaranteed to be in input order
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Index < results[j].Index
+	})
+
+	return results, nil
+}
@@ -0,0 +1,240 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestBulkOperationRunMutationWithItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type productInput struct {
+		Input struct {
+			Title string `json:"title"`
+		} `json:"input"`
+	}
+
+	items := make([]productInput, 3)
+	items[0].Input.Title = "a"
+	items[1].Input.Title = "b"
+	items[2].Input.Title = "c"
+
+	var stagedUploadPath, mutation string
+	polls := 0
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			switch {
+			case strings.Contains(q, "stagedUploadsCreate"):
+				return httpmock.NewStringResponse(200, `{"data":{"stagedUploadsCreate":{
+					"stagedTargets":[{
+						"url":"`+testStagedUploadUrl+`",
+						"resourceUrl":"`+testStagedUploadUrl+`tmp/bulk/bulk_op_vars",
+						"parameters":[{"name":"key","value":"tmp/bulk/bulk_op_vars"}]
+					}],
+					"userErrors":[]
+				}}}`), nil
+			case strings.Contains(q, "bulkOperationRunMutation("):
+				_ = json.Unmarshal(vars["stagedUploadPath"], &stagedUploadPath)
+				_ = json.Unmarshal(vars["mutation"], &mutation)
+				return httpmock.NewStringResponse(200, `{"data":{"bulkOperationRunMutation":{
+					"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CREATED"},
+					"userErrors":[]
+				}}}`), nil
+			case strings.Contains(q, "node(id: $id)"):
+				polls++
+				if polls < 2 {
+					return httpmock.NewStringResponse(200, `{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"RUNNING"}}}`), nil
+				}
+				return httpmock.NewStringResponse(200, `{"data":{"node":{
+					"id":"gid://shopify/BulkOperation/1",
+					"status":"COMPLETED",
+					"objectCount":"3",
+					"url":"https://storage.googleapis.com/results.jsonl"
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(400, ""), nil
+		},
+	)
+
+	var uploaded string
+	httpmock.RegisterResponder(
+		"POST",
+		testStagedUploadUrl,
+		func(req *http.Request) (*http.Response, error) {
+			_ = req.ParseMultipartForm(1024)
+			f, _, _ := req.FormFile("file")
+			b, _ := ioutil.ReadAll(f)
+			uploaded = string(b)
+			return httpmock.NewStringResponse(201, ""), nil
+		},
+	)
+
+	httpmock.RegisterResponder(
+		"GET",
+		"https://storage.googleapis.com/results.jsonl",
+		httpmock.NewStringResponder(200, `{"data":{"productCreate":{"product":{"id":"gid://shopify/Product/2"},"userErrors":[]}},"__lineNumber":1}
+{"data":{"productCreate":{"product":{"id":"gid://shopify/Product/1"},"userErrors":[]}},"__lineNumber":0}
+{"data":{"productCreate":{"product":null,"userErrors":[{"field":["title"],"message":"Title is taken"}]}},"__lineNumber":2}
+`),
+	)
+
+	m := `mutation call($input: ProductInput!) { productCreate(input: $input) { product { id } userErrors { field message } } }`
+	results, err := client.BulkOperation.RunMutationWithItems(context.Background(), m, items, 1)
+	if err != nil {
+		t.Fatalf("BulkOperation.RunMutationWithItems returned error: %v", err)
+	}
+
+	expectedUpload := `{"input":{"title":"a"}}
+{"input":{"title":"b"}}
+{"input":{"title":"c"}}
+`
+	if uploaded != expectedUpload {
+		t.Errorf("BulkOperation.RunMutationWithItems uploaded %s, expected %s", uploaded, expectedUpload)
+	}
+
+	if stagedUploadPath != "tmp/bulk/bulk_op_vars" {
+		t.Errorf("BulkOperation.RunMutationWithItems used staged upload path %s", stagedUploadPath)
+	}
+
+	if mutation != m {
+		t.Errorf("BulkOperation.RunMutationWithItems ran mutation %s, expected %s", mutation, m)
+	}
+
+	if polls != 2 {
+		t.Errorf("BulkOperation.RunMutationWithItems polled %d times, expected 2", polls)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("BulkOperation.RunMutationWithItems returned %d results, expected 3", len(results))
+	}
+
+	for i, result := range results {
+		if result.Index != i || !reflect.DeepEqual(result.Input, items[i]) {
+			t.Errorf("BulkOperation.RunMutationWithItems result %d is %+v, expected input %+v", i, result, items[i])
+		}
+	}
+
+	expectedData := `{"product":{"id":"gid://shopify/Product/1"},"userErrors":[]}`
+	if string(results[0].Data) != expectedData {
+		t.Errorf("BulkOperation.RunMutationWithItems result data %s, expected %s", results[0].Data, expectedData)
+	}
+
+	expectedUserErrors := []GraphQLUserError{{Field: []string{"title"}, Message: "Title is taken"}}
+	if !reflect.DeepEqual(results[2].UserErrors, expectedUserErrors) {
+		t.Errorf("BulkOperation.RunMutationWithItems user errors %+v, expected %+v", results[2].UserErrors, expectedUserErrors)
+	}
+}
+
+func TestBulkOperationRunMutationWithItemsNotSlice(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.BulkOperation.RunMutationWithItems(context.Background(), "", "foo", 1)
+	if err == nil {
+		t.Error("BulkOperation.RunMutationWithItems expected error for non slice items")
+	}
+}
+
+func TestBulkOperationWaitFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"node":{"id":"gid://shopify/BulkOperation/1","status":"FAILED","errorCode":"INTERNAL_SERVER_ERROR"}}}`),
+	)
+
+	op, err := client.BulkOperation.Wait(context.Background(), NewGID(GIDResourceBulkOperation, 1), 1)
+	if err != nil {
+		t.Fatalf("BulkOperation.Wait returned error: %v", err)
+	}
+
+	if op.Status != BulkOperationStatusFailed || op.ErrorCode != "INTERNAL_SERVER_ERROR" {
+		t.Errorf("BulkOperation.Wait returned %+v", op)
+	}
+}
+
+func TestBulkOperationCancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"bulkOperationCancel":{"bulkOperation":{"id":"gid://shopify/BulkOperation/1","status":"CANCELING"},"userErrors":[]}}}`),
+	)
+
+	op, err := client.BulkOperation.Cancel(context.Background(), NewGID(GIDResourceBulkOperation, 1))
+	if err != nil {
+		t.Fatalf("BulkOperation.Cancel returned error: %v", err)
+	}
+
+	if op.Status != BulkOperationStatusCanceling {
+		t.Errorf("BulkOperation.Cancel returned status %s, expected %s", op.Status, BulkOperationStatusCanceling)
+	}
+}
+
+func TestBulkOperationRunMutationNoOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"bulkOperationRunMutation":{"bulkOperation":null,"userErrors":[]}}}`),
+	)
+
+	op, err := client.BulkOperation.RunMutation(context.Background(), "mutation { foo }", "tmp/bulk/bulk_op_vars")
+
+	expectedErr := "bulk mutation was not started, no bulk operation returned"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("BulkOperation.RunMutation returned error %v, expected %s", err, expectedErr)
+	}
+	if op != nil {
+		t.Errorf("BulkOperation.RunMutation returned %+v, expected nil", op)
+	}
+}
+
+func TestBulkOperationRunMutationWithItemsNoKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, _ := graphQLRequestBody(req)
+			if strings.Contains(q, "stagedUploadsCreate") {
+				return httpmock.NewStringResponse(200, `{"data":{"stagedUploadsCreate":{
+					"stagedTargets":[{"url":"`+testStagedUploadUrl+`","parameters":[]}],
+					"userErrors":[]
+				}}}`), nil
+			}
+			t.Errorf("BulkOperation.RunMutationWithItems sent unexpected query %s", q)
+			return httpmock.NewStringResponse(400, ""), nil
+		},
+	)
+	httpmock.RegisterResponder("POST", testStagedUploadUrl, httpmock.NewStringResponder(201, ""))
+
+	items := []map[string]string{{"title": "a"}}
+	_, err := client.BulkOperation.RunMutationWithItems(context.Background(), "mutation { foo }", items, 1)
+
+	expectedErr := "staged upload target has no key parameter"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("BulkOperation.RunMutationWithItems returned error %v, expected %s", err, expectedErr)
+	}
+}
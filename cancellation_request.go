@@ -0,0 +1,56 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+)
+
+// CancellationRequestService is an interface for interfacing with the
+// cancellation request endpoints of the Shopify API. A merchant sends a
+// cancellation request for a fulfillment order that was accepted by a
+// fulfillment service, which then accepts or rejects it.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/cancellationrequest
+type CancellationRequestService interface {
+	Send(context.Context, uint64, CancellationRequest) (*FulfillmentOrder, error)
+	Accept(context.Context, uint64, CancellationRequest) (*FulfillmentOrder, error)
+	Reject(context.Context, uint64, CancellationRequest) (*FulfillmentOrder, error)
+}
+
+// CancellationRequestServiceOp handles communication with the cancellation
+// request related methods of the Shopify API.
+type CancellationRequestServiceOp struct {
+	client *Client
+}
+
+type CancellationRequest struct {
+	Message string `json:"message,omitempty"`
+}
+
+type CancellationRequestResource struct {
+	CancellationRequest CancellationRequest `json:"cancellation_request"`
+}
+
+// Send sends a cancellation request to the fulfillment service of a fulfillment order.
+func (s *CancellationRequestServiceOp) Send(ctx context.Context, fulfillmentOrderId uint64, request CancellationRequest) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/cancellation_request.json", fulfillmentRequestBasePath, fulfillmentOrderId)
+	return s.post(ctx, path, request)
+}
+
+// Accept accepts a cancellation request sent to a fulfillment service for a fulfillment order.
+func (s *CancellationRequestServiceOp) Accept(ctx context.Context, fulfillmentOrderId uint64, request CancellationRequest) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/cancellation_request/accept.json", fulfillmentRequestBasePath, fulfillmentOrderId)
+	return s.post(ctx, path, request)
+}
+
+// Reject rejects a cancellation request sent to a fulfillment service for a fulfillment order.
+func (s *CancellationRequestServiceOp) Reject(ctx context.Context, fulfillmentOrderId uint64, request CancellationRequest) (*FulfillmentOrder, error) {
+	path := fmt.Sprintf("%s/%d/cancellation_request/reject.json", fulfillmentRequestBasePath, fulfillmentOrderId)
+	return s.post(ctx, path, request)
+}
+
+func (s *CancellationRequestServiceOp) post(ctx context.Context, path string, request CancellationRequest) (*FulfillmentOrder, error) {
+	wrappedData := CancellationRequestResource{CancellationRequest: request}
+	resource := new(FulfillmentOrderResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.FulfillmentOrder, err
+}
@@ -0,0 +1,66 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCancellationRequestSend(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1/cancellation_request.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("fulfillment_order.json")), nil
+		})
+
+	fulfillmentOrder, err := client.CancellationRequest.Send(context.Background(), 1, CancellationRequest{Message: "The customer changed his mind."})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Send returned error: %v", err)
+	}
+
+	expectedBody := `{"cancellation_request":{"message":"The customer changed his mind."}}`
+	if body != expectedBody {
+		t.Errorf("CancellationRequest.Send sent %s, expected %s", body, expectedBody)
+	}
+
+	FulfillmentOrderTests(t, *fulfillmentOrder)
+}
+
+func TestCancellationRequestAccept(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1/cancellation_request/accept.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.CancellationRequest.Accept(context.Background(), 1, CancellationRequest{Message: "We had not started any processing yet."})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Accept returned error: %v", err)
+	}
+
+	FulfillmentOrderTests(t, *fulfillmentOrder)
+}
+
+func TestCancellationRequestReject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1/cancellation_request/reject.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("fulfillment_order.json")))
+
+	fulfillmentOrder, err := client.CancellationRequest.Reject(context.Background(), 1, CancellationRequest{Message: "The items have already been shipped."})
+	if err != nil {
+		t.Fatalf("CancellationRequest.Reject returned error: %v", err)
+	}
+
+	FulfillmentOrderTests(t, *fulfillmentOrder)
+}
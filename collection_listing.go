@@ -0,0 +1,156 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const collectionListingBasePath = "collection_listings"
+
+// CollectionListingService is an interface for interfacing with the collection listing endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/collectionlisting
+type CollectionListingService interface {
+	List(context.Context, interface{}) ([]CollectionListing, error)
+	ListAll(context.Context, interface{}) ([]CollectionListing, error)
+	ListWithPagination(context.Context, interface{}) ([]CollectionListing, *Pagination, error)
+	Get(context.Context, uint64, interface{}) (*CollectionListing, error)
+	GetProductIds(context.Context, uint64, interface{}) ([]uint64, error)
+	Publish(context.Context, uint64) (*CollectionListing, error)
+	Delete(context.Context, uint64) error
+}
+
+// CollectionListingServiceOp handles communication with the collection listing related methods of
+// the Shopify API.
+type CollectionListingServiceOp struct {
+	client *Client
+}
+
+// CollectionListing represents a Shopify collection published to your sales channel app
+type CollectionListing struct {
+	Id                  uint64                  `json:"collection_id,omitempty"`
+	Title               string                  `json:"title,omitempty"`
+	BodyHTML            string                  `json:"body_html,omitempty"`
+	Handle              string                  `json:"handle,omitempty"`
+	SortOrder           string                  `json:"sort_order,omitempty"`
+	Image               *CollectionListingImage `json:"image,omitempty"`
+	DefaultProductImage *CollectionListingImage `json:"default_product_image,omitempty"`
+	PublishedAt         *time.Time              `json:"published_at,omitempty"`
+	UpdatedAt           *time.Time              `json:"updated_at,omitempty"`
+}
+
+// CollectionListingImage represents the image of a collection listing
+type CollectionListingImage struct {
+	Src       string     `json:"src,omitempty"`
+	Alt       string     `json:"alt,omitempty"`
+	Width     int        `json:"width,omitempty"`
+	Height    int        `json:"height,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// Represents the result from the collection_listings/X.json endpoint
+type CollectionListingResource struct {
+	CollectionListing *CollectionListing `json:"collection_listing"`
+}
+
+// Represents the result from the collection_listings.json endpoint
+type CollectionListingsResource struct {
+	CollectionListings []CollectionListing `json:"collection_listings"`
+}
+
+// Represents the result from the collection_listings/X/product_ids.json endpoint
+type CollectionListingProductIdsResource struct {
+	ProductIds []uint64 `json:"product_ids"`
+}
+
+// Resource which create collection_listing endpoint expects in request body
+// e.g.
+// PUT /admin/api/2024-04/collection_listings/482865238.json
+//
+//	{
+//	  "collection_listing": {
+//	    "collection_id": 482865238
+//	  }
+//	}
+type CollectionListingPublishResource struct {
+	CollectionListing struct {
+		CollectionId uint64 `json:"collection_id"`
+	} `json:"collection_listing"`
+}
+
+// List collection listings
+func (s *CollectionListingServiceOp) List(ctx context.Context, options interface{}) ([]CollectionListing, error) {
+	listings, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return listings, nil
+}
+
+// ListAll Lists all collection listings, iterating over pages
+func (s *CollectionListingServiceOp) ListAll(ctx context.Context, options interface{}) ([]CollectionListing, error) {
+	collector := []CollectionListing{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+// ListWithPagination lists collection listings and return pagination to retrieve next/previous results.
+func (s *CollectionListingServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]CollectionListing, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", collectionListingBasePath)
+	resource := new(CollectionListingsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.CollectionListings, pagination, nil
+}
+
+// Get individual collection_listing by collection Id
+func (s *CollectionListingServiceOp) Get(ctx context.Context, collectionId uint64, options interface{}) (*CollectionListing, error) {
+	path := fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionId)
+	resource := new(CollectionListingResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.CollectionListing, err
+}
+
+// GetProductIds lists the product Ids of a collection that are published to your sales channel
+func (s *CollectionListingServiceOp) GetProductIds(ctx context.Context, collectionId uint64, options interface{}) ([]uint64, error) {
+	path := fmt.Sprintf("%s/%d/product_ids.json", collectionListingBasePath, collectionId)
+	resource := new(CollectionListingProductIdsResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.ProductIds, err
+}
+
+// Publish an existing collection listing to your sales channel app
+func (s *CollectionListingServiceOp) Publish(ctx context.Context, collectionId uint64) (*CollectionListing, error) {
+	path := fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionId)
+	wrappedData := new(CollectionListingPublishResource)
+	wrappedData.CollectionListing.CollectionId = collectionId
+	resource := new(CollectionListingResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.CollectionListing, err
+}
+
+// Delete unpublishes an existing collection from your sales channel app.
+func (s *CollectionListingServiceOp) Delete(ctx context.Context, collectionId uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", collectionListingBasePath, collectionId))
+}
@@ -0,0 +1,154 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func collectionListingTests(t *testing.T, listing CollectionListing) {
+	var expectedId uint64 = 482865238
+	if listing.Id != expectedId {
+		t.Errorf("CollectionListing.Id returned %+v, expected %+v", listing.Id, expectedId)
+	}
+
+	expectedHandle := "smart-ipods"
+	if listing.Handle != expectedHandle {
+		t.Errorf("CollectionListing.Handle returned %+v, expected %+v", listing.Handle, expectedHandle)
+	}
+
+	if listing.Image == nil || listing.Image.Width != 123 || listing.Image.Height != 456 {
+		t.Errorf("CollectionListing.Image returned %+v", listing.Image)
+	}
+
+	if listing.DefaultProductImage != nil {
+		t.Errorf("CollectionListing.DefaultProductImage returned %+v, expected nil", listing.DefaultProductImage)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedPublishedAt := time.Date(2024, time.January, 2, 9, 0, 0, 0, location)
+	if listing.PublishedAt == nil || !expectedPublishedAt.Equal(*listing.PublishedAt) {
+		t.Errorf("CollectionListing.PublishedAt returned %+v, expected %+v", listing.PublishedAt, expectedPublishedAt)
+	}
+}
+
+func TestCollectionListingList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"collection_listings": [{"collection_id":1},{"collection_id":2}]}`))
+
+	listings, err := client.CollectionListing.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("CollectionListing.List returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{Id: 1}, {Id: 2}}
+	if !reflect.DeepEqual(listings, expected) {
+		t.Errorf("CollectionListing.List returned %+v, expected %+v", listings, expected)
+	}
+}
+
+func TestCollectionListingListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page_info") == "pg2" {
+			return httpmock.NewStringResponse(200, `{"collection_listings": [{"collection_id":3}]}`), nil
+		}
+		resp := httpmock.NewStringResponse(200, `{"collection_listings": [{"collection_id":1},{"collection_id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	listings, err := client.CollectionListing.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Errorf("CollectionListing.ListAll returned error: %v", err)
+	}
+
+	expected := []CollectionListing{{Id: 1}, {Id: 2}, {Id: 3}}
+	if !reflect.DeepEqual(listings, expected) {
+		t.Errorf("CollectionListing.ListAll returned %+v, expected %+v", listings, expected)
+	}
+}
+
+func TestCollectionListingGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("collection_listing.json")))
+
+	listing, err := client.CollectionListing.Get(context.Background(), 482865238, nil)
+	if err != nil {
+		t.Fatalf("CollectionListing.Get returned error: %v", err)
+	}
+
+	collectionListingTests(t, *listing)
+}
+
+func TestCollectionListingGetProductIds(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238/product_ids.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"product_ids": [632910392, 921728736]}`))
+
+	ids, err := client.CollectionListing.GetProductIds(context.Background(), 482865238, nil)
+	if err != nil {
+		t.Errorf("CollectionListing.GetProductIds returned error: %v", err)
+	}
+
+	expected := []uint64{632910392, 921728736}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("CollectionListing.GetProductIds returned %+v, expected %+v", ids, expected)
+	}
+}
+
+func TestCollectionListingPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("collection_listing.json")), nil
+		})
+
+	listing, err := client.CollectionListing.Publish(context.Background(), 482865238)
+	if err != nil {
+		t.Fatalf("CollectionListing.Publish returned error: %v", err)
+	}
+
+	expectedBody := `{"collection_listing":{"collection_id":482865238}}`
+	if body != expectedBody {
+		t.Errorf("CollectionListing.Publish sent %s, expected %s", body, expectedBody)
+	}
+
+	collectionListingTests(t, *listing)
+}
+
+func TestCollectionListingDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/collection_listings/482865238.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CollectionListing.Delete(context.Background(), 482865238)
+	if err != nil {
+		t.Errorf("CollectionListing.Delete returned error: %v", err)
+	}
+}
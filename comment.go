@@ -0,0 +1,203 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const commentsBasePath = "comments"
+
+// CommentService is an interface for interfacing with the comment endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/comment
+type CommentService interface {
+	List(context.Context, interface{}) ([]Comment, error)
+	ListAll(context.Context, interface{}) ([]Comment, error)
+	ListWithPagination(context.Context, interface{}) ([]Comment, *Pagination, error)
+	Count(context.Context, interface{}) (int, error)
+	Get(context.Context, uint64, interface{}) (*Comment, error)
+	Create(context.Context, Comment) (*Comment, error)
+	Update(context.Context, Comment) (*Comment, error)
+	Spam(context.Context, uint64) (*Comment, error)
+	NotSpam(context.Context, uint64) (*Comment, error)
+	Approve(context.Context, uint64) (*Comment, error)
+	Remove(context.Context, uint64) (*Comment, error)
+	Restore(context.Context, uint64) (*Comment, error)
+}
+
+// CommentServiceOp handles communication with the comment related methods of
+// the Shopify API.
+type CommentServiceOp struct {
+	client *Client
+}
+
+type CommentStatus string
+
+const (
+	CommentStatusPending    CommentStatus = "pending"
+	CommentStatusUnapproved CommentStatus = "unapproved"
+	CommentStatusPublished  CommentStatus = "published"
+	CommentStatusSpam       CommentStatus = "spam"
+	CommentStatusRemoved    CommentStatus = "removed"
+)
+
+// Comment represents a Shopify comment on a blog article
+type Comment struct {
+	Id          uint64        `json:"id,omitempty"`
+	BlogId      uint64        `json:"blog_id,omitempty"`
+	ArticleId   uint64        `json:"article_id,omitempty"`
+	Author      string        `json:"author,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	Body        string        `json:"body,omitempty"`
+	BodyHtml    string        `json:"body_html,omitempty"`
+	Status      CommentStatus `json:"status,omitempty"`
+	Ip          string        `json:"ip,omitempty"`
+	UserAgent   string        `json:"user_agent,omitempty"`
+	PublishedAt *time.Time    `json:"published_at,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+}
+
+// CommentListOptions A struct for all available comment list options.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/comment#get-comments
+type CommentListOptions struct {
+	ListOptions
+	BlogId          uint64        `url:"blog_id,omitempty"`
+	ArticleId       uint64        `url:"article_id,omitempty"`
+	Status          CommentStatus `url:"status,omitempty"`
+	PublishedStatus string        `url:"published_status,omitempty"`
+	PublishedAtMin  *time.Time    `url:"published_at_min,omitempty"`
+	PublishedAtMax  *time.Time    `url:"published_at_max,omitempty"`
+}
+
+// CommentCountOptions A struct for all available comment count options.
+type CommentCountOptions struct {
+	CountOptions
+	BlogId          uint64        `url:"blog_id,omitempty"`
+	ArticleId       uint64        `url:"article_id,omitempty"`
+	Status          CommentStatus `url:"status,omitempty"`
+	PublishedStatus string        `url:"published_status,omitempty"`
+	PublishedAtMin  *time.Time    `url:"published_at_min,omitempty"`
+	PublishedAtMax  *time.Time    `url:"published_at_max,omitempty"`
+}
+
+// CommentResource represents the result from the comments/X.json endpoint
+type CommentResource struct {
+	Comment *Comment `json:"comment"`
+}
+
+// CommentsResource represents the result from the comments.json endpoint
+type CommentsResource struct {
+	Comments []Comment `json:"comments"`
+}
+
+// List comments
+func (s *CommentServiceOp) List(ctx context.Context, options interface{}) ([]Comment, error) {
+	comments, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// ListAll Lists all comments, iterating over pages
+func (s *CommentServiceOp) ListAll(ctx context.Context, options interface{}) ([]Comment, error) {
+	collector := []Comment{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *CommentServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]Comment, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	resource := new(CommentsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Comments, pagination, nil
+}
+
+// Count comments
+func (s *CommentServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", commentsBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual comment
+func (s *CommentServiceOp) Get(ctx context.Context, commentId uint64, options interface{}) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, commentId)
+	resource := new(CommentResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Comment, err
+}
+
+// Create a new comment on an article, BlogId and ArticleId are required
+func (s *CommentServiceOp) Create(ctx context.Context, comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s.json", commentsBasePath)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Update an existing comment
+func (s *CommentServiceOp) Update(ctx context.Context, comment Comment) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d.json", commentsBasePath, comment.Id)
+	wrappedData := CommentResource{Comment: &comment}
+	resource := new(CommentResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Comment, err
+}
+
+// Spam marks a comment as spam
+func (s *CommentServiceOp) Spam(ctx context.Context, commentId uint64) (*Comment, error) {
+	return s.moderate(ctx, commentId, "spam")
+}
+
+// NotSpam marks a comment as not spam, restoring it to published or pending
+func (s *CommentServiceOp) NotSpam(ctx context.Context, commentId uint64) (*Comment, error) {
+	return s.moderate(ctx, commentId, "not_spam")
+}
+
+// Approve approves a comment and publishes it
+func (s *CommentServiceOp) Approve(ctx context.Context, commentId uint64) (*Comment, error) {
+	return s.moderate(ctx, commentId, "approve")
+}
+
+// Remove removes a comment
+func (s *CommentServiceOp) Remove(ctx context.Context, commentId uint64) (*Comment, error) {
+	return s.moderate(ctx, commentId, "remove")
+}
+
+// Restore restores a previously removed comment
+func (s *CommentServiceOp) Restore(ctx context.Context, commentId uint64) (*Comment, error) {
+	return s.moderate(ctx, commentId, "restore")
+}
+
+// moderate posts a moderation action, the endpoints respond with the comment
+// itself rather than wrapping it in a comment key
+func (s *CommentServiceOp) moderate(ctx context.Context, commentId uint64, action string) (*Comment, error) {
+	path := fmt.Sprintf("%s/%d/%s.json", commentsBasePath, commentId, action)
+	resource := new(Comment)
+	err := s.client.Post(ctx, path, nil, resource)
+	return resource, err
+}
@@ -0,0 +1,219 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func commentTests(t *testing.T, comment Comment) {
+	expectedId := uint64(653537639)
+	if comment.Id != expectedId {
+		t.Errorf("Comment.Id returned %+v, expected %+v", comment.Id, expectedId)
+	}
+
+	if comment.BlogId != 241253187 || comment.ArticleId != 134645308 {
+		t.Errorf("Comment returned blog id %d and article id %d", comment.BlogId, comment.ArticleId)
+	}
+
+	expectedStatus := CommentStatusUnapproved
+	if comment.Status != expectedStatus {
+		t.Errorf("Comment.Status returned %+v, expected %+v", comment.Status, expectedStatus)
+	}
+
+	expectedAuthor := "Soleone"
+	if comment.Author != expectedAuthor {
+		t.Errorf("Comment.Author returned %+v, expected %+v", comment.Author, expectedAuthor)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedCreatedAt := time.Date(2024, time.January, 2, 9, 0, 0, 0, location)
+	if comment.CreatedAt == nil || !expectedCreatedAt.Equal(*comment.CreatedAt) {
+		t.Errorf("Comment.CreatedAt returned %+v, expected %+v", comment.CreatedAt, expectedCreatedAt)
+	}
+}
+
+func TestCommentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comments.json")))
+
+	comments, err := client.Comment.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+
+	if len(comments) != 2 || comments[0].Id != 653537639 || comments[1].Status != CommentStatusPublished {
+		t.Errorf("Comment.List returned %+v", comments)
+	}
+}
+
+func TestCommentListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"blog_id": "241253187", "article_id": "134645308", "status": "spam"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"comments": [{"id":1},{"id":2}]}`))
+
+	options := CommentListOptions{BlogId: 241253187, ArticleId: 134645308, Status: CommentStatusSpam}
+	comments, err := client.Comment.List(context.Background(), options)
+	if err != nil {
+		t.Errorf("Comment.List returned error: %v", err)
+	}
+
+	expected := []Comment{{Id: 1}, {Id: 2}}
+	if !reflect.DeepEqual(comments, expected) {
+		t.Errorf("Comment.List returned %+v, expected %+v", comments, expected)
+	}
+}
+
+func TestCommentListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page_info") == "pg2" {
+			return httpmock.NewStringResponse(200, `{"comments": [{"id":3}]}`), nil
+		}
+		resp := httpmock.NewStringResponse(200, `{"comments": [{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	comments, err := client.Comment.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Comment.ListAll returned error: %v", err)
+	}
+
+	expected := []Comment{{Id: 1}, {Id: 2}, {Id: 3}}
+	if !reflect.DeepEqual(comments, expected) {
+		t.Errorf("Comment.ListAll returned %+v, expected %+v", comments, expected)
+	}
+}
+
+func TestCommentCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"article_id": "134645308"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/count.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Comment.Count(context.Background(), CommentCountOptions{ArticleId: 134645308})
+	if err != nil {
+		t.Errorf("Comment.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Comment.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCommentGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Get(context.Background(), 653537639, nil)
+	if err != nil {
+		t.Fatalf("Comment.Get returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("comment.json")), nil
+		})
+
+	comment, err := client.Comment.Create(context.Background(), Comment{
+		BlogId:    241253187,
+		ArticleId: 134645308,
+		Author:    "Soleone",
+		Email:     "sole@one.de",
+		Body:      "Hi author, I really _like_ what you're doing there.",
+	})
+	if err != nil {
+		t.Fatalf("Comment.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"comment":{"blog_id":241253187,"article_id":134645308,"author":"Soleone","email":"sole@one.de","body":"Hi author, I really _like_ what you're doing there."}}`
+	if body != expectedBody {
+		t.Errorf("Comment.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("comment.json")))
+
+	comment, err := client.Comment.Update(context.Background(), Comment{Id: 653537639, Author: "Soleone"})
+	if err != nil {
+		t.Fatalf("Comment.Update returned error: %v", err)
+	}
+
+	commentTests(t, *comment)
+}
+
+func TestCommentModeration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		action   string
+		status   CommentStatus
+		moderate func(context.Context, uint64) (*Comment, error)
+	}{
+		{"spam", CommentStatusSpam, client.Comment.Spam},
+		{"not_spam", CommentStatusPublished, client.Comment.NotSpam},
+		{"approve", CommentStatusPublished, client.Comment.Approve},
+		{"remove", CommentStatusRemoved, client.Comment.Remove},
+		{"restore", CommentStatusPublished, client.Comment.Restore},
+	}
+
+	for _, c := range cases {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/comments/653537639/%s.json", client.pathPrefix, c.action),
+			httpmock.NewStringResponder(201, fmt.Sprintf(`{"id":653537639,"status":"%s"}`, c.status)))
+
+		comment, err := c.moderate(context.Background(), 653537639)
+		if err != nil {
+			t.Fatalf("Comment %s returned error: %v", c.action, err)
+		}
+
+		expected := &Comment{Id: 653537639, Status: c.status}
+		if !reflect.DeepEqual(comment, expected) {
+			t.Errorf("Comment %s returned %+v, expected %+v", c.action, comment, expected)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+const countriesBasePath = "countries"
+
+// CountryService is an interface for interfacing with the country endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/country
+type CountryService interface {
+	List(context.Context, interface{}) ([]Country, error)
+	Count(context.Context, interface{}) (int, error)
+	Get(context.Context, uint64, interface{}) (*Country, error)
+	Create(context.Context, Country) (*Country, error)
+	Update(context.Context, Country) (*Country, error)
+	Delete(context.Context, uint64) error
+}
+
+// CountryServiceOp handles communication with the country related methods of
+// the Shopify API.
+type CountryServiceOp struct {
+	client *Client
+}
+
+// Country represents a Shopify country and its tax configuration. The id is
+// the one referenced by PriceRule.EntitledCountryIds.
+type Country struct {
+	Id        uint64           `json:"id,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Code      string           `json:"code,omitempty"`
+	TaxName   string           `json:"tax_name,omitempty"`
+	Tax       *decimal.Decimal `json:"tax,omitempty"`
+	Provinces []Province       `json:"provinces,omitempty"`
+}
+
+// CountryResource represents the result from the countries/X.json endpoint
+type CountryResource struct {
+	Country *Country `json:"country"`
+}
+
+// CountriesResource represents the result from the countries.json endpoint
+type CountriesResource struct {
+	Countries []Country `json:"countries"`
+}
+
+// List countries
+func (s *CountryServiceOp) List(ctx context.Context, options interface{}) ([]Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	resource := new(CountriesResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Countries, err
+}
+
+// Count countries
+func (s *CountryServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", countriesBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual country
+func (s *CountryServiceOp) Get(ctx context.Context, countryId uint64, options interface{}) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, countryId)
+	resource := new(CountryResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Country, err
+}
+
+// Create a new country
+func (s *CountryServiceOp) Create(ctx context.Context, country Country) (*Country, error) {
+	path := fmt.Sprintf("%s.json", countriesBasePath)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Country, err
+}
+
+// Update an existing country, e.g. to override its tax rate
+func (s *CountryServiceOp) Update(ctx context.Context, country Country) (*Country, error) {
+	path := fmt.Sprintf("%s/%d.json", countriesBasePath, country.Id)
+	wrappedData := CountryResource{Country: &country}
+	resource := new(CountryResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Country, err
+}
+
+// Delete an existing country
+func (s *CountryServiceOp) Delete(ctx context.Context, countryId uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", countriesBasePath, countryId))
+}
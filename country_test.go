@@ -0,0 +1,143 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func countryTests(t *testing.T, country Country) {
+	expectedId := uint64(879921427)
+	if country.Id != expectedId {
+		t.Errorf("Country.Id returned %+v, expected %+v", country.Id, expectedId)
+	}
+
+	expectedCode := "CA"
+	if country.Code != expectedCode {
+		t.Errorf("Country.Code returned %+v, expected %+v", country.Code, expectedCode)
+	}
+
+	expectedTax := decimal.NewFromFloat(0.05)
+	if country.Tax == nil || !country.Tax.Equal(expectedTax) {
+		t.Errorf("Country.Tax returned %+v, expected %+v", country.Tax, expectedTax)
+	}
+
+	if len(country.Provinces) != 1 {
+		t.Fatalf("Country.Provinces returned %d provinces, expected 1", len(country.Provinces))
+	}
+
+	provinceTests(t, country.Provinces[0])
+}
+
+func TestCountryList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("countries.json")))
+
+	countries, err := client.Country.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Country.List returned error: %v", err)
+	}
+
+	if len(countries) != 2 || countries[0].Code != "CA" || countries[1].Code != "US" {
+		t.Errorf("Country.List returned %+v", countries)
+	}
+}
+
+func TestCountryCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.Country.Count(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Country.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("Country.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCountryGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	country, err := client.Country.Get(context.Background(), 879921427, nil)
+	if err != nil {
+		t.Fatalf("Country.Get returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("country.json")))
+
+	country, err := client.Country.Create(context.Background(), Country{Code: "CA"})
+	if err != nil {
+		t.Fatalf("Country.Create returned error: %v", err)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("country.json")), nil
+		})
+
+	tax := decimal.NewFromFloat(0.05)
+	country, err := client.Country.Update(context.Background(), Country{Id: 879921427, Tax: &tax})
+	if err != nil {
+		t.Fatalf("Country.Update returned error: %v", err)
+	}
+
+	var sent CountryResource
+	if err := json.Unmarshal([]byte(body), &sent); err != nil {
+		t.Fatalf("Country.Update sent invalid body %s: %v", body, err)
+	}
+	if sent.Country == nil || sent.Country.Tax == nil || !sent.Country.Tax.Equal(tax) {
+		t.Errorf("Country.Update sent %s", body)
+	}
+
+	countryTests(t, *country)
+}
+
+func TestCountryDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Country.Delete(context.Background(), 879921427)
+	if err != nil {
+		t.Errorf("Country.Delete returned error: %v", err)
+	}
+}
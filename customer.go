@@ -28,6 +28,9 @@ type CustomerService interface {
 	Delete(context.Context, uint64) error
 	ListOrders(context.Context, uint64, interface{}) ([]Order, error)
 	ListTags(context.Context, interface{}) ([]string, error)
+	SendInvite(context.Context, uint64, CustomerInvite) (*CustomerInvite, error)
+	GetAccountActivationUrl(context.Context, uint64) (string, error)
+	Merge(context.Context, uint64, uint64, *CustomerMergeOverrideFields) (*CustomerMergeResult, error)
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
 	MetafieldsService
@@ -85,6 +88,46 @@ type CustomerTagsResource struct {
 	Tags []string `json:"tags"`
 }
 
+// CustomerInvite represents an account invite email sent to a customer, all
+// fields are optional and default to the shop's invite template
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Bcc           []string `json:"bcc,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+}
+
+// Represents the result from the customers/X/send_invite.json endpoint
+type CustomerInviteResource struct {
+	CustomerInvite *CustomerInvite `json:"customer_invite"`
+}
+
+// Represents the result from the customers/X/account_activation_url.json endpoint
+type CustomerAccountActivationUrlResource struct {
+	AccountActivationUrl string `json:"account_activation_url"`
+}
+
+// CustomerMergeOverrideFields selects which customer's details are kept when
+// merging two customers, unset fields are resolved by Shopify
+type CustomerMergeOverrideFields struct {
+	CustomerIdOfFirstNameToKeep      uint64
+	CustomerIdOfLastNameToKeep       uint64
+	CustomerIdOfEmailToKeep          uint64
+	CustomerIdOfPhoneNumberToKeep    uint64
+	CustomerIdOfDefaultAddressToKeep uint64
+	Note                             string
+	Tags                             []string
+}
+
+// CustomerMergeResult represents the result of merging two customers. The
+// merge runs asynchronously, JobId identifies the job that performs it.
+type CustomerMergeResult struct {
+	ResultingCustomerId uint64
+	JobId               GID
+	Done                bool
+}
+
 // Represents the options available when searching for a customer
 type CustomerSearchOptions struct {
 	Page   int    `url:"page,omitempty"`
@@ -213,6 +256,101 @@ func (s *CustomerServiceOp) ListTags(ctx context.Context, options interface{}) (
 	return resource.Tags, err
 }
 
+// SendInvite sends an account invite email to a customer
+func (s *CustomerServiceOp) SendInvite(ctx context.Context, customerId uint64, invite CustomerInvite) (*CustomerInvite, error) {
+	path := fmt.Sprintf("%s/%d/send_invite.json", customersBasePath, customerId)
+	wrappedData := CustomerInviteResource{CustomerInvite: &invite}
+	resource := new(CustomerInviteResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.CustomerInvite, err
+}
+
+// GetAccountActivationUrl generates a one-time account activation url for a
+// customer whose account is not yet enabled
+func (s *CustomerServiceOp) GetAccountActivationUrl(ctx context.Context, customerId uint64) (string, error) {
+	path := fmt.Sprintf("%s/%d/account_activation_url.json", customersBasePath, customerId)
+	resource := new(CustomerAccountActivationUrlResource)
+	err := s.client.Post(ctx, path, nil, resource)
+	return resource.AccountActivationUrl, err
+}
+
+const customerMergeMutation = `mutation customerMerge($customerOneId: ID!, $customerTwoId: ID!, $overrideFields: CustomerMergeOverrideFields) {
+  customerMerge(customerOneId: $customerOneId, customerTwoId: $customerTwoId, overrideFields: $overrideFields) {
+    resultingCustomerId
+    job {
+      id
+      done
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// Merge merges two customers through the graphql endpoint, the resulting
+// customer is one of the two and the other one is deleted
+func (s *CustomerServiceOp) Merge(ctx context.Context, customerOneId, customerTwoId uint64, overrideFields *CustomerMergeOverrideFields) (*CustomerMergeResult, error) {
+	vars := map[string]interface{}{
+		"customerOneId": NewGID(GIDResourceCustomer, customerOneId),
+		"customerTwoId": NewGID(GIDResourceCustomer, customerTwoId),
+	}
+	if overrideFields != nil {
+		vars["overrideFields"] = overrideFields.toInput()
+	}
+	resp := struct {
+		CustomerMerge struct {
+			ResultingCustomerId GID `json:"resultingCustomerId"`
+			Job                 *struct {
+				Id   GID  `json:"id"`
+				Done bool `json:"done"`
+			} `json:"job"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"customerMerge"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, customerMergeMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.CustomerMerge.UserErrors); err != nil {
+		return nil, err
+	}
+
+	result := &CustomerMergeResult{ResultingCustomerId: resp.CustomerMerge.ResultingCustomerId.Id}
+	if resp.CustomerMerge.Job != nil {
+		result.JobId = resp.CustomerMerge.Job.Id
+		result.Done = resp.CustomerMerge.Job.Done
+	}
+
+	return result, nil
+}
+
+func (f *CustomerMergeOverrideFields) toInput() map[string]interface{} {
+	input := map[string]interface{}{}
+	ids := map[string]uint64{
+		"customerIdOfFirstNameToKeep":      f.CustomerIdOfFirstNameToKeep,
+		"customerIdOfLastNameToKeep":       f.CustomerIdOfLastNameToKeep,
+		"customerIdOfEmailToKeep":          f.CustomerIdOfEmailToKeep,
+		"customerIdOfPhoneNumberToKeep":    f.CustomerIdOfPhoneNumberToKeep,
+		"customerIdOfDefaultAddressToKeep": f.CustomerIdOfDefaultAddressToKeep,
+	}
+	for k, id := range ids {
+		if id != 0 {
+			input[k] = NewGID(GIDResourceCustomer, id)
+		}
+	}
+	if f.Note != "" {
+		input["note"] = f.Note
+	}
+	if f.Tags != nil {
+		input["tags"] = f.Tags
+	}
+	return input
+}
+
 // List metafields for a customer
 func (s *CustomerServiceOp) ListMetafields(ctx context.Context, customerId uint64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceId: customerId}
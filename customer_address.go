@@ -14,6 +14,8 @@ type CustomerAddressService interface {
 	Create(context.Context, uint64, CustomerAddress) (*CustomerAddress, error)
 	Update(context.Context, uint64, CustomerAddress) (*CustomerAddress, error)
 	Delete(context.Context, uint64, uint64) error
+	SetDefault(context.Context, uint64, uint64) (*CustomerAddress, error)
+	BulkDelete(context.Context, uint64, []uint64) error
 }
 
 // CustomerAddressServiceOp handles communication with the customer address related methods of
@@ -53,6 +55,12 @@ type CustomerAddressesResource struct {
 	Addresses []CustomerAddress `json:"addresses"`
 }
 
+// customerAddressSetOptions represents the query of the addresses/set.json endpoint
+type customerAddressSetOptions struct {
+	AddressIds []uint64 `url:"address_ids[]"`
+	Operation  string   `url:"operation"`
+}
+
 // List addresses
 func (s *CustomerAddressServiceOp) List(ctx context.Context, customerId uint64, options interface{}) ([]CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerId)
@@ -91,3 +99,19 @@ func (s *CustomerAddressServiceOp) Update(ctx context.Context, customerId uint64
 func (s *CustomerAddressServiceOp) Delete(ctx context.Context, customerId, addressId uint64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerId, addressId))
 }
+
+// SetDefault sets the default address of a customer
+func (s *CustomerAddressServiceOp) SetDefault(ctx context.Context, customerId, addressId uint64) (*CustomerAddress, error) {
+	path := fmt.Sprintf("%s/%d/addresses/%d/default.json", customersBasePath, customerId, addressId)
+	resource := new(CustomerAddressResource)
+	err := s.client.Put(ctx, path, nil, resource)
+	return resource.Address, err
+}
+
+// BulkDelete deletes multiple addresses of a customer, the default address
+// cannot be deleted
+func (s *CustomerAddressServiceOp) BulkDelete(ctx context.Context, customerId uint64, addressIds []uint64) error {
+	path := fmt.Sprintf("%s/%d/addresses/set.json", customersBasePath, customerId)
+	options := customerAddressSetOptions{AddressIds: addressIds, Operation: "destroy"}
+	return s.client.CreateAndDo(ctx, "PUT", path, nil, options, nil)
+}
@@ -3,6 +3,9 @@ package goshopify
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -165,3 +168,39 @@ func TestDelete(t *testing.T) {
 		t.Errorf("CustomerAddress.Update returned error: %v", err)
 	}
 }
+
+func TestSetDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/1/default.json", client.pathPrefix), httpmock.NewBytesResponder(200, loadFixture("customer_address.json")))
+
+	address, err := client.CustomerAddress.SetDefault(context.Background(), 1, 1)
+	if err != nil {
+		t.Errorf("CustomerAddress.SetDefault returned error: %v", err)
+	}
+
+	verifyAddress(t, *address)
+}
+
+func TestBulkDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query url.Values
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/set.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query = req.URL.Query()
+			return httpmock.NewStringResponse(200, "{}"), nil
+		})
+
+	err := client.CustomerAddress.BulkDelete(context.Background(), 1, []uint64{2, 3})
+	if err != nil {
+		t.Errorf("CustomerAddress.BulkDelete returned error: %v", err)
+	}
+
+	expected := url.Values{"address_ids[]": {"2", "3"}, "operation": {"destroy"}}
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("CustomerAddress.BulkDelete sent %v, expected %v", query, expected)
+	}
+}
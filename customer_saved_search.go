@@ -0,0 +1,139 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const customerSavedSearchesBasePath = "customer_saved_searches"
+
+// CustomerSavedSearchService is an interface for interfacing with the customer
+// saved search endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/customersavedsearch
+type CustomerSavedSearchService interface {
+	List(context.Context, interface{}) ([]CustomerSavedSearch, error)
+	ListAll(context.Context, interface{}) ([]CustomerSavedSearch, error)
+	ListWithPagination(context.Context, interface{}) ([]CustomerSavedSearch, *Pagination, error)
+	Count(context.Context, interface{}) (int, error)
+	Get(context.Context, uint64, interface{}) (*CustomerSavedSearch, error)
+	Create(context.Context, CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Update(context.Context, CustomerSavedSearch) (*CustomerSavedSearch, error)
+	Delete(context.Context, uint64) error
+	ListCustomers(context.Context, uint64, interface{}) ([]Customer, error)
+}
+
+// CustomerSavedSearchServiceOp handles communication with the customer saved
+// search related methods of the Shopify API.
+type CustomerSavedSearchServiceOp struct {
+	client *Client
+}
+
+// CustomerSavedSearch represents a Shopify customer saved search, i.e. a
+// named customer search query
+type CustomerSavedSearch struct {
+	Id        uint64     `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Query     string     `json:"query,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Represents the result from the customer_saved_searches/X.json endpoint
+type CustomerSavedSearchResource struct {
+	CustomerSavedSearch *CustomerSavedSearch `json:"customer_saved_search"`
+}
+
+// Represents the result from the customer_saved_searches.json endpoint
+type CustomerSavedSearchesResource struct {
+	CustomerSavedSearches []CustomerSavedSearch `json:"customer_saved_searches"`
+}
+
+// List customer saved searches
+func (s *CustomerSavedSearchServiceOp) List(ctx context.Context, options interface{}) ([]CustomerSavedSearch, error) {
+	searches, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+// ListAll Lists all customer saved searches, iterating over pages
+func (s *CustomerSavedSearchServiceOp) ListAll(ctx context.Context, options interface{}) ([]CustomerSavedSearch, error) {
+	collector := []CustomerSavedSearch{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *CustomerSavedSearchServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]CustomerSavedSearch, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	resource := new(CustomerSavedSearchesResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.CustomerSavedSearches, pagination, nil
+}
+
+// Count customer saved searches
+func (s *CustomerSavedSearchServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", customerSavedSearchesBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual customer saved search
+func (s *CustomerSavedSearchServiceOp) Get(ctx context.Context, searchId uint64, options interface{}) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchId)
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.CustomerSavedSearch, err
+}
+
+// Create a new customer saved search
+func (s *CustomerSavedSearchServiceOp) Create(ctx context.Context, search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s.json", customerSavedSearchesBasePath)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Update an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Update(ctx context.Context, search CustomerSavedSearch) (*CustomerSavedSearch, error) {
+	path := fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, search.Id)
+	wrappedData := CustomerSavedSearchResource{CustomerSavedSearch: &search}
+	resource := new(CustomerSavedSearchResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.CustomerSavedSearch, err
+}
+
+// Delete an existing customer saved search
+func (s *CustomerSavedSearchServiceOp) Delete(ctx context.Context, searchId uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", customerSavedSearchesBasePath, searchId))
+}
+
+// ListCustomers lists the customers matching a customer saved search
+func (s *CustomerSavedSearchServiceOp) ListCustomers(ctx context.Context, searchId uint64, options interface{}) ([]Customer, error) {
+	path := fmt.Sprintf("%s/%d/customers.json", customerSavedSearchesBasePath, searchId)
+	resource := new(CustomersResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Customers, err
+}
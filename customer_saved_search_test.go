@@ -0,0 +1,145 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func customerSavedSearchTests(t *testing.T, search CustomerSavedSearch) {
+	expectedId := uint64(789629109)
+	if search.Id != expectedId {
+		t.Errorf("CustomerSavedSearch.Id returned %+v, expected %+v", search.Id, expectedId)
+	}
+
+	expectedName := "Accepts Marketing"
+	if search.Name != expectedName {
+		t.Errorf("CustomerSavedSearch.Name returned %+v, expected %+v", search.Name, expectedName)
+	}
+
+	expectedQuery := "accepts_marketing:1"
+	if search.Query != expectedQuery {
+		t.Errorf("CustomerSavedSearch.Query returned %+v, expected %+v", search.Query, expectedQuery)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedCreatedAt := time.Date(2024, time.January, 2, 9, 0, 0, 0, location)
+	if search.CreatedAt == nil || !expectedCreatedAt.Equal(*search.CreatedAt) {
+		t.Errorf("CustomerSavedSearch.CreatedAt returned %+v, expected %+v", search.CreatedAt, expectedCreatedAt)
+	}
+}
+
+func TestCustomerSavedSearchList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_searches.json")))
+
+	searches, err := client.CustomerSavedSearch.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.List returned error: %v", err)
+	}
+
+	if len(searches) != 2 {
+		t.Fatalf("CustomerSavedSearch.List returned %d searches, expected 2", len(searches))
+	}
+
+	customerSavedSearchTests(t, searches[0])
+}
+
+func TestCustomerSavedSearchCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.CustomerSavedSearch.Count(context.Background(), nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("CustomerSavedSearch.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestCustomerSavedSearchGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Get(context.Background(), 789629109, nil)
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Get returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Create(context.Background(), CustomerSavedSearch{Name: "Accepts Marketing", Query: "accepts_marketing:1"})
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Create returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_saved_search.json")))
+
+	search, err := client.CustomerSavedSearch.Update(context.Background(), CustomerSavedSearch{Id: 789629109, Name: "Accepts Marketing"})
+	if err != nil {
+		t.Fatalf("CustomerSavedSearch.Update returned error: %v", err)
+	}
+
+	customerSavedSearchTests(t, *search)
+}
+
+func TestCustomerSavedSearchDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CustomerSavedSearch.Delete(context.Background(), 789629109)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.Delete returned error: %v", err)
+	}
+}
+
+func TestCustomerSavedSearchListCustomers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customer_saved_searches/789629109/customers.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"customers": [{"id":1},{"id":2}]}`))
+
+	customers, err := client.CustomerSavedSearch.ListCustomers(context.Background(), 789629109, nil)
+	if err != nil {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned error: %v", err)
+	}
+
+	if len(customers) != 2 || customers[0].Id != 1 || customers[1].Id != 2 {
+		t.Errorf("CustomerSavedSearch.ListCustomers returned %+v", customers)
+	}
+}
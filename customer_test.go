@@ -2,8 +2,10 @@ package goshopify
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"runtime"
@@ -762,3 +764,111 @@ func TestCustomerListTags(t *testing.T) {
 		t.Errorf("Customer.ListTags got %v as the first tag, expected: 'tag1'", tags[0])
 	}
 }
+
+func TestCustomerSendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/send_invite.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"customer_invite":{"to":"bob@example.com","from":"steve@apple.com","subject":"Welcome to my new shop","custom_message":"My awesome new store","bcc":[]}}`), nil
+		})
+
+	invite, err := client.Customer.SendInvite(context.Background(), 1, CustomerInvite{Subject: "Welcome to my new shop", CustomMessage: "My awesome new store"})
+	if err != nil {
+		t.Fatalf("Customer.SendInvite returned error: %v", err)
+	}
+
+	expectedBody := `{"customer_invite":{"subject":"Welcome to my new shop","custom_message":"My awesome new store"}}`
+	if body != expectedBody {
+		t.Errorf("Customer.SendInvite sent %s, expected %s", body, expectedBody)
+	}
+
+	expected := &CustomerInvite{To: "bob@example.com", From: "steve@apple.com", Bcc: []string{}, Subject: "Welcome to my new shop", CustomMessage: "My awesome new store"}
+	if !reflect.DeepEqual(invite, expected) {
+		t.Errorf("Customer.SendInvite returned %+v, expected %+v", invite, expected)
+	}
+}
+
+func TestCustomerGetAccountActivationUrl(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/account_activation_url.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"account_activation_url":"https://fooshop.myshopify.com/account/activate/1/abc-123"}`))
+
+	url, err := client.Customer.GetAccountActivationUrl(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Customer.GetAccountActivationUrl returned error: %v", err)
+	}
+
+	expected := "https://fooshop.myshopify.com/account/activate/1/abc-123"
+	if url != expected {
+		t.Errorf("Customer.GetAccountActivationUrl returned %s, expected %s", url, expected)
+	}
+}
+
+func TestCustomerMerge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]json.RawMessage
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars = graphQLRequestBody(req)
+			return httpmock.NewStringResponse(200, `{"data":{"customerMerge":{
+				"resultingCustomerId":"gid://shopify/Customer/2",
+				"job":{"id":"gid://shopify/Job/abc","done":false},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	result, err := client.Customer.Merge(context.Background(), 1, 2, &CustomerMergeOverrideFields{CustomerIdOfEmailToKeep: 1, Note: "merged"})
+	if err != nil {
+		t.Fatalf("Customer.Merge returned error: %v", err)
+	}
+
+	expectedVars := map[string]string{
+		"customerOneId":  `"gid://shopify/Customer/1"`,
+		"customerTwoId":  `"gid://shopify/Customer/2"`,
+		"overrideFields": `{"customerIdOfEmailToKeep":"gid://shopify/Customer/1","note":"merged"}`,
+	}
+	for k, v := range expectedVars {
+		if string(vars[k]) != v {
+			t.Errorf("Customer.Merge sent %s=%s, expected %s", k, vars[k], v)
+		}
+	}
+
+	expected := &CustomerMergeResult{ResultingCustomerId: 2, JobId: GID{Resource: GIDResourceJob, Key: "abc"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Customer.Merge returned %+v, expected %+v", result, expected)
+	}
+}
+
+func TestCustomerMergeUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"customerMerge":{
+			"resultingCustomerId":null,
+			"job":null,
+			"userErrors":[{"field":["customerTwoId"],"message":"Customer has a pending gift card","code":"INVALID_CUSTOMER"}]
+		}}}`),
+	)
+
+	_, err := client.Customer.Merge(context.Background(), 1, 2, nil)
+
+	expected := "customerTwoId: Customer has a pending gift card"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Customer.Merge returned error %v, expected %s", err, expected)
+	}
+}
@@ -0,0 +1,618 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/shopspring/decimal"
+)
+
+// DeliveryProfileService is an interface for managing delivery profiles, and
+// the shipping zones and rates they contain, through the graphql endpoint of
+// the Shopify API.
+// See: https://shopify.dev/docs/apps/selling-strategies/shipping/delivery-profiles
+type DeliveryProfileService interface {
+	List(context.Context) ([]DeliveryProfile, error)
+	Get(context.Context, GID) (*DeliveryProfile, error)
+	Create(context.Context, DeliveryProfileInput) (*DeliveryProfile, error)
+	Update(context.Context, GID, DeliveryProfileInput) (*DeliveryProfile, error)
+	Delete(context.Context, GID) (GID, error)
+}
+
+// DeliveryProfileServiceOp handles communication with the delivery profile
+// related methods of the Shopify API.
+type DeliveryProfileServiceOp struct {
+	client *Client
+}
+
+// DeliveryRateProviderType is the graphql type of a rate provider
+type DeliveryRateProviderType string
+
+const (
+	// DeliveryRateProviderTypeRateDefinition is a flat rate
+	DeliveryRateProviderTypeRateDefinition DeliveryRateProviderType = "DeliveryRateDefinition"
+
+	// DeliveryRateProviderTypeParticipant is a rate calculated by a carrier
+	// service
+	DeliveryRateProviderTypeParticipant DeliveryRateProviderType = "DeliveryParticipant"
+)
+
+// DeliveryConditionField is the field a delivery condition applies to
+type DeliveryConditionField string
+
+const (
+	DeliveryConditionFieldTotalWeight DeliveryConditionField = "TOTAL_WEIGHT"
+	DeliveryConditionFieldTotalPrice  DeliveryConditionField = "TOTAL_PRICE"
+)
+
+// DeliveryConditionOperator is the comparison of a delivery condition
+type DeliveryConditionOperator string
+
+const (
+	DeliveryConditionOperatorGreaterThanOrEqualTo DeliveryConditionOperator = "GREATER_THAN_OR_EQUAL_TO"
+	DeliveryConditionOperatorLessThanOrEqualTo    DeliveryConditionOperator = "LESS_THAN_OR_EQUAL_TO"
+)
+
+// WeightUnit is the unit of a weight as used by the graphql API
+type WeightUnit string
+
+const (
+	WeightUnitGrams     WeightUnit = "GRAMS"
+	WeightUnitKilograms WeightUnit = "KILOGRAMS"
+	WeightUnitOunces    WeightUnit = "OUNCES"
+	WeightUnitPounds    WeightUnit = "POUNDS"
+)
+
+// Weight represents a weight as used by the graphql API
+type Weight struct {
+	Unit  WeightUnit      `json:"unit"`
+	Value decimal.Decimal `json:"value"`
+}
+
+// MarshalJSON sends the value as a number, as the graphql API expects
+func (w Weight) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Unit  WeightUnit   `json:"unit"`
+		Value *json.Number `json:"value"`
+	}{w.Unit, decimalNumber(&w.Value)})
+}
+
+// DeliveryProfile represents a group of products that share shipping
+// settings, i.e. the zones and rates of each group of locations
+type DeliveryProfile struct {
+	Id                    GID                            `json:"id"`
+	Name                  string                         `json:"name,omitempty"`
+	Default               bool                           `json:"default"`
+	ProfileLocationGroups []DeliveryProfileLocationGroup `json:"profileLocationGroups,omitempty"`
+}
+
+// DeliveryProfileLocationGroup represents the zones of a group of locations
+// that ship with the same rates
+type DeliveryProfileLocationGroup struct {
+	LocationGroup      DeliveryLocationGroup       `json:"locationGroup"`
+	LocationGroupZones []DeliveryLocationGroupZone `json:"locationGroupZones,omitempty"`
+}
+
+// DeliveryLocationGroup represents a group of locations
+type DeliveryLocationGroup struct {
+	Id GID `json:"id"`
+}
+
+// DeliveryLocationGroupZone represents a shipping zone and its rates
+type DeliveryLocationGroupZone struct {
+	Zone              DeliveryZone               `json:"zone"`
+	MethodDefinitions []DeliveryMethodDefinition `json:"methodDefinitions,omitempty"`
+}
+
+// DeliveryZone represents the countries and provinces of a shipping zone
+type DeliveryZone struct {
+	Id        GID               `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Countries []DeliveryCountry `json:"countries,omitempty"`
+}
+
+// DeliveryCountry represents a country of a shipping zone
+type DeliveryCountry struct {
+	Id        GID                 `json:"id"`
+	Name      string              `json:"name,omitempty"`
+	Code      DeliveryCountryCode `json:"code"`
+	Provinces []DeliveryProvince  `json:"provinces,omitempty"`
+}
+
+// DeliveryCountryCode represents the code of a country, or the rest of the
+// world
+type DeliveryCountryCode struct {
+	CountryCode string `json:"countryCode,omitempty"`
+	RestOfWorld bool   `json:"restOfWorld"`
+}
+
+// DeliveryProvince represents a province of a shipping zone
+type DeliveryProvince struct {
+	Id   GID    `json:"id"`
+	Name string `json:"name,omitempty"`
+	Code string `json:"code,omitempty"`
+}
+
+// DeliveryMethodDefinition represents a shipping rate of a zone
+type DeliveryMethodDefinition struct {
+	Id               GID                  `json:"id"`
+	Name             string               `json:"name,omitempty"`
+	Description      string               `json:"description,omitempty"`
+	Active           bool                 `json:"active"`
+	RateProvider     DeliveryRateProvider `json:"rateProvider"`
+	MethodConditions []DeliveryCondition  `json:"methodConditions,omitempty"`
+}
+
+// DeliveryRateProvider represents either a flat rate or a carrier calculated
+// rate, Typename tells which of the fields are set
+type DeliveryRateProvider struct {
+	Typename DeliveryRateProviderType `json:"__typename"`
+	Id       GID                      `json:"id"`
+
+	// Price is set for flat rates
+	Price *MoneyV2 `json:"price,omitempty"`
+
+	// The remaining fields are set for carrier calculated rates
+	CarrierService         *DeliveryCarrierService      `json:"carrierService,omitempty"`
+	FixedFee               *MoneyV2                     `json:"fixedFee,omitempty"`
+	PercentageOfRateFee    *decimal.Decimal             `json:"percentageOfRateFee,omitempty"`
+	AdaptToNewServicesFlag bool                         `json:"adaptToNewServicesFlag,omitempty"`
+	ParticipantServices    []DeliveryParticipantService `json:"participantServices,omitempty"`
+}
+
+// DeliveryCarrierService represents the carrier service of a carrier
+// calculated rate
+type DeliveryCarrierService struct {
+	Id            GID    `json:"id"`
+	FormattedName string `json:"formattedName,omitempty"`
+}
+
+// DeliveryParticipantService represents a service offered by a carrier
+type DeliveryParticipantService struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+// DeliveryCondition represents a weight or price condition of a rate
+type DeliveryCondition struct {
+	Id                GID                       `json:"id"`
+	Field             DeliveryConditionField    `json:"field"`
+	Operator          DeliveryConditionOperator `json:"operator"`
+	ConditionCriteria DeliveryConditionCriteria `json:"conditionCriteria"`
+}
+
+// DeliveryConditionCriteria represents the value a condition compares
+// against, Typename is either MoneyV2 or Weight
+type DeliveryConditionCriteria struct {
+	Typename     string           `json:"__typename"`
+	Amount       *decimal.Decimal `json:"amount,omitempty"`
+	CurrencyCode string           `json:"currencyCode,omitempty"`
+	Unit         WeightUnit       `json:"unit,omitempty"`
+	Value        *decimal.Decimal `json:"value,omitempty"`
+}
+
+// DeliveryProfileInput represents the input of the deliveryProfileCreate and
+// deliveryProfileUpdate mutations
+type DeliveryProfileInput struct {
+	Name                      string                              `json:"name,omitempty"`
+	ProfileLocationGroups     []DeliveryProfileLocationGroupInput `json:"profileLocationGroups,omitempty"`
+	LocationGroupsToDelete    []GID                               `json:"locationGroupsToDelete,omitempty"`
+	ZonesToDelete             []GID                               `json:"zonesToDelete,omitempty"`
+	MethodDefinitionsToDelete []GID                               `json:"methodDefinitionsToDelete,omitempty"`
+	ConditionsToDelete        []GID                               `json:"conditionsToDelete,omitempty"`
+	VariantsToAssociate       []GID                               `json:"variantsToAssociate,omitempty"`
+	VariantsToDissociate      []GID                               `json:"variantsToDissociate,omitempty"`
+}
+
+// DeliveryProfileLocationGroupInput represents a location group to create or
+// update, Id is left unset to create a new group
+type DeliveryProfileLocationGroupInput struct {
+	Id                *GID                             `json:"id,omitempty"`
+	Locations         []GID                            `json:"locations,omitempty"`
+	LocationsToAdd    []GID                            `json:"locationsToAdd,omitempty"`
+	LocationsToRemove []GID                            `json:"locationsToRemove,omitempty"`
+	ZonesToCreate     []DeliveryLocationGroupZoneInput `json:"zonesToCreate,omitempty"`
+	ZonesToUpdate     []DeliveryLocationGroupZoneInput `json:"zonesToUpdate,omitempty"`
+}
+
+// DeliveryLocationGroupZoneInput represents a shipping zone to create or
+// update
+type DeliveryLocationGroupZoneInput struct {
+	Id                        *GID                            `json:"id,omitempty"`
+	Name                      string                          `json:"name,omitempty"`
+	Countries                 []DeliveryCountryInput          `json:"countries,omitempty"`
+	MethodDefinitionsToCreate []DeliveryMethodDefinitionInput `json:"methodDefinitionsToCreate,omitempty"`
+	MethodDefinitionsToUpdate []DeliveryMethodDefinitionInput `json:"methodDefinitionsToUpdate,omitempty"`
+}
+
+// DeliveryCountryInput represents a country of a shipping zone, either Code
+// or RestOfWorld is set
+type DeliveryCountryInput struct {
+	Code                string                  `json:"code,omitempty"`
+	RestOfWorld         bool                    `json:"restOfWorld,omitempty"`
+	IncludeAllProvinces bool                    `json:"includeAllProvinces,omitempty"`
+	Provinces           []DeliveryProvinceInput `json:"provinces,omitempty"`
+}
+
+// DeliveryProvinceInput represents a province of a shipping zone
+type DeliveryProvinceInput struct {
+	Code string `json:"code"`
+}
+
+// DeliveryMethodDefinitionInput represents a shipping rate to create or
+// update, either RateDefinition or Participant is set
+type DeliveryMethodDefinitionInput struct {
+	Id                       *GID                           `json:"id,omitempty"`
+	Name                     string                         `json:"name,omitempty"`
+	Description              string                         `json:"description,omitempty"`
+	Active                   *bool                          `json:"active,omitempty"`
+	RateDefinition           *DeliveryRateDefinitionInput   `json:"rateDefinition,omitempty"`
+	Participant              *DeliveryParticipantInput      `json:"participant,omitempty"`
+	WeightConditionsToCreate []DeliveryWeightConditionInput `json:"weightConditionsToCreate,omitempty"`
+	PriceConditionsToCreate  []DeliveryPriceConditionInput  `json:"priceConditionsToCreate,omitempty"`
+	ConditionsToUpdate       []DeliveryUpdateConditionInput `json:"conditionsToUpdate,omitempty"`
+}
+
+// DeliveryRateDefinitionInput represents a flat rate
+type DeliveryRateDefinitionInput struct {
+	Id    *GID    `json:"id,omitempty"`
+	Price MoneyV2 `json:"price"`
+}
+
+// DeliveryParticipantInput represents a carrier calculated rate
+type DeliveryParticipantInput struct {
+	Id                  *GID                         `json:"id,omitempty"`
+	CarrierServiceId    *GID                         `json:"carrierServiceId,omitempty"`
+	FixedFee            *MoneyV2                     `json:"fixedFee,omitempty"`
+	PercentageOfRateFee *decimal.Decimal             `json:"percentageOfRateFee,omitempty"`
+	ParticipantServices []DeliveryParticipantService `json:"participantServices,omitempty"`
+	AdaptToNewServices  *bool                        `json:"adaptToNewServices,omitempty"`
+}
+
+// DeliveryWeightConditionInput represents a weight condition of a rate
+type DeliveryWeightConditionInput struct {
+	Operator DeliveryConditionOperator `json:"operator"`
+	Criteria Weight                    `json:"criteria"`
+}
+
+// DeliveryPriceConditionInput represents a price condition of a rate
+type DeliveryPriceConditionInput struct {
+	Operator DeliveryConditionOperator `json:"operator"`
+	Criteria MoneyV2                   `json:"criteria"`
+}
+
+// DeliveryUpdateConditionInput represents a change to an existing condition
+type DeliveryUpdateConditionInput struct {
+	Id           GID                       `json:"id"`
+	Criteria     *decimal.Decimal          `json:"criteria,omitempty"`
+	CriteriaUnit string                    `json:"criteriaUnit,omitempty"`
+	Field        DeliveryConditionField    `json:"field,omitempty"`
+	Operator     DeliveryConditionOperator `json:"operator,omitempty"`
+}
+
+// MarshalJSON sends the percentage fee as a number, as the graphql API
+// expects
+func (p DeliveryParticipantInput) MarshalJSON() ([]byte, error) {
+	type alias DeliveryParticipantInput
+	return json.Marshal(struct {
+		alias
+		PercentageOfRateFee *json.Number `json:"percentageOfRateFee,omitempty"`
+	}{alias(p), decimalNumber(p.PercentageOfRateFee)})
+}
+
+// MarshalJSON sends the criteria as a number, as the graphql API expects
+func (c DeliveryUpdateConditionInput) MarshalJSON() ([]byte, error) {
+	type alias DeliveryUpdateConditionInput
+	return json.Marshal(struct {
+		alias
+		Criteria *json.Number `json:"criteria,omitempty"`
+	}{alias(c), decimalNumber(c.Criteria)})
+}
+
+// decimalNumber returns d as a JSON number rather than the string a decimal
+// marshals to, for the Float arguments of the graphql API
+func decimalNumber(d *decimal.Decimal) *json.Number {
+	if d == nil {
+		return nil
+	}
+	n := json.Number(d.String())
+	return &n
+}
+
+// UnmarshalJSON flattens the locationGroupZones connection
+func (g *DeliveryProfileLocationGroup) UnmarshalJSON(b []byte) error {
+	type alias DeliveryProfileLocationGroup
+	aux := struct {
+		*alias
+		LocationGroupZones *struct {
+			Nodes []DeliveryLocationGroupZone `json:"nodes"`
+		} `json:"locationGroupZones"`
+	}{alias: (*alias)(g)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if aux.LocationGroupZones != nil {
+		g.LocationGroupZones = aux.LocationGroupZones.Nodes
+	}
+
+	return nil
+}
+
+// UnmarshalJSON flattens the methodDefinitions connection
+func (z *DeliveryLocationGroupZone) UnmarshalJSON(b []byte) error {
+	type alias DeliveryLocationGroupZone
+	aux := struct {
+		*alias
+		MethodDefinitions *struct {
+			Nodes []DeliveryMethodDefinition `json:"nodes"`
+		} `json:"methodDefinitions"`
+	}{alias: (*alias)(z)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if aux.MethodDefinitions != nil {
+		z.MethodDefinitions = aux.MethodDefinitions.Nodes
+	}
+
+	return nil
+}
+
+const deliveryProfileFields = `
+    id
+    name
+    default
+    profileLocationGroups {
+      locationGroup {
+        id
+      }
+      locationGroupZones(first: 25) {
+        nodes {
+          zone {
+            id
+            name
+            countries {
+              id
+              name
+              code {
+                countryCode
+                restOfWorld
+              }
+              provinces {
+                id
+                name
+                code
+              }
+            }
+          }
+          methodDefinitions(first: 25) {
+            nodes {
+              id
+              name
+              description
+              active
+              rateProvider {
+                __typename
+                ... on DeliveryRateDefinition {
+                  id
+                  price {
+                    amount
+                    currencyCode
+                  }
+                }
+                ... on DeliveryParticipant {
+                  id
+                  carrierService {
+                    id
+                    formattedName
+                  }
+                  fixedFee {
+                    amount
+                    currencyCode
+                  }
+                  percentageOfRateFee
+                  adaptToNewServicesFlag
+                  participantServices {
+                    name
+                    active
+                  }
+                }
+              }
+              methodConditions {
+                id
+                field
+                operator
+                conditionCriteria {
+                  __typename
+                  ... on MoneyV2 {
+                    amount
+                    currencyCode
+                  }
+                  ... on Weight {
+                    unit
+                    value
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+`
+
+const deliveryProfilesQuery = `query deliveryProfiles($after: String) {
+  deliveryProfiles(first: 50, after: $after) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {
+      id
+      name
+      default
+    }
+  }
+}`
+
+const deliveryProfileQuery = `query deliveryProfile($id: ID!) {
+  deliveryProfile(id: $id) {` + deliveryProfileFields + `}
+}`
+
+const deliveryProfileCreateMutation = `mutation deliveryProfileCreate($profile: DeliveryProfileInput!) {
+  deliveryProfileCreate(profile: $profile) {
+    profile {` + deliveryProfileFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const deliveryProfileUpdateMutation = `mutation deliveryProfileUpdate($id: ID!, $profile: DeliveryProfileInput!) {
+  deliveryProfileUpdate(id: $id, profile: $profile) {
+    profile {` + deliveryProfileFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const deliveryProfileRemoveMutation = `mutation deliveryProfileRemove($id: ID!) {
+  deliveryProfileRemove(id: $id) {
+    job {
+      id
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// List lists all delivery profiles of the shop, iterating over pages. Only
+// the id, name and default flag are returned, use Get for the zones and
+// rates of a profile.
+func (s *DeliveryProfileServiceOp) List(ctx context.Context) ([]DeliveryProfile, error) {
+	collector := []DeliveryProfile{}
+	vars := map[string]interface{}{}
+
+	for {
+		resp := struct {
+			DeliveryProfiles struct {
+				PageInfo GraphQLPageInfo   `json:"pageInfo"`
+				Nodes    []DeliveryProfile `json:"nodes"`
+			} `json:"deliveryProfiles"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, deliveryProfilesQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.DeliveryProfiles.Nodes...)
+
+		if !resp.DeliveryProfiles.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.DeliveryProfiles.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Get retrieves a delivery profile with its zones and rates. Up to 25 zones
+// per location group and 25 rates per zone are returned.
+func (s *DeliveryProfileServiceOp) Get(ctx context.Context, id GID) (*DeliveryProfile, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		DeliveryProfile *DeliveryProfile `json:"deliveryProfile"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, deliveryProfileQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.DeliveryProfile, nil
+}
+
+// Create creates a delivery profile, including any location groups, zones
+// and rates of the input
+func (s *DeliveryProfileServiceOp) Create(ctx context.Context, profile DeliveryProfileInput) (*DeliveryProfile, error) {
+	vars := map[string]interface{}{"profile": profile}
+	resp := struct {
+		DeliveryProfileCreate struct {
+			Profile    *DeliveryProfile   `json:"profile"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"deliveryProfileCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, deliveryProfileCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.DeliveryProfileCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.DeliveryProfileCreate.Profile, nil
+}
+
+// Update updates a delivery profile, zones and rates are created, updated
+// and deleted through the nested fields of the input
+func (s *DeliveryProfileServiceOp) Update(ctx context.Context, id GID, profile DeliveryProfileInput) (*DeliveryProfile, error) {
+	vars := map[string]interface{}{"id": id, "profile": profile}
+	resp := struct {
+		DeliveryProfileUpdate struct {
+			Profile    *DeliveryProfile   `json:"profile"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"deliveryProfileUpdate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, deliveryProfileUpdateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.DeliveryProfileUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.DeliveryProfileUpdate.Profile, nil
+}
+
+// Delete schedules the removal of a delivery profile, its products are moved
+// to the default profile. The id of the job doing the removal is returned.
+func (s *DeliveryProfileServiceOp) Delete(ctx context.Context, id GID) (GID, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		DeliveryProfileRemove struct {
+			Job *struct {
+				Id GID `json:"id"`
+			} `json:"job"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"deliveryProfileRemove"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, deliveryProfileRemoveMutation, vars, &resp)
+	if err != nil {
+		return GID{}, err
+	}
+
+	if err := userErrorsToError(resp.DeliveryProfileRemove.UserErrors); err != nil {
+		return GID{}, err
+	}
+
+	if resp.DeliveryProfileRemove.Job == nil {
+		return GID{}, nil
+	}
+
+	return resp.DeliveryProfileRemove.Job.Id, nil
+}
@@ -0,0 +1,319 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func deliveryProfileTests(t *testing.T, profile DeliveryProfile) {
+	expectedId := NewGID(GIDResourceDeliveryProfile, 690933843)
+	if !reflect.DeepEqual(profile.Id, expectedId) {
+		t.Errorf("DeliveryProfile.Id returned %+v, expected %+v", profile.Id, expectedId)
+	}
+
+	if profile.Name != "General profile" || !profile.Default {
+		t.Errorf("DeliveryProfile returned name %s and default %t", profile.Name, profile.Default)
+	}
+
+	if len(profile.ProfileLocationGroups) != 1 || len(profile.ProfileLocationGroups[0].LocationGroupZones) != 1 {
+		t.Fatalf("DeliveryProfile.ProfileLocationGroups returned %+v", profile.ProfileLocationGroups)
+	}
+
+	group := profile.ProfileLocationGroups[0]
+	expectedGroupId := NewGID(GIDResourceDeliveryLocationGroup, 955592433)
+	if !reflect.DeepEqual(group.LocationGroup.Id, expectedGroupId) {
+		t.Errorf("DeliveryProfileLocationGroup.LocationGroup.Id returned %+v, expected %+v", group.LocationGroup.Id, expectedGroupId)
+	}
+
+	zone := group.LocationGroupZones[0]
+	expectedCountries := []DeliveryCountry{{
+		Id:        MustParseGID("gid://shopify/DeliveryCountry/817138619"),
+		Name:      "United States",
+		Code:      DeliveryCountryCode{CountryCode: "US"},
+		Provinces: []DeliveryProvince{{Id: MustParseGID("gid://shopify/DeliveryProvince/1069646656"), Name: "New York", Code: "NY"}},
+	}}
+	if zone.Zone.Name != "Domestic" || !reflect.DeepEqual(zone.Zone.Countries, expectedCountries) {
+		t.Errorf("DeliveryLocationGroupZone.Zone returned %+v, expected countries %+v", zone.Zone, expectedCountries)
+	}
+
+	if len(zone.MethodDefinitions) != 2 {
+		t.Fatalf("DeliveryLocationGroupZone.MethodDefinitions returned %d rates, expected 2", len(zone.MethodDefinitions))
+	}
+
+	flat := zone.MethodDefinitions[0]
+	if flat.RateProvider.Typename != DeliveryRateProviderTypeRateDefinition || flat.RateProvider.Price == nil ||
+		!flat.RateProvider.Price.Amount.Equal(decimal.RequireFromString("5.05")) {
+		t.Errorf("DeliveryMethodDefinition.RateProvider returned %+v", flat.RateProvider)
+	}
+
+	expectedCondition := DeliveryCondition{
+		Id:                MustParseGID("gid://shopify/DeliveryCondition/1?operator=greater_than_or_equal_to"),
+		Field:             DeliveryConditionFieldTotalWeight,
+		Operator:          DeliveryConditionOperatorGreaterThanOrEqualTo,
+		ConditionCriteria: DeliveryConditionCriteria{Typename: "Weight", Unit: WeightUnitKilograms},
+	}
+	if len(flat.MethodConditions) != 1 {
+		t.Fatalf("DeliveryMethodDefinition.MethodConditions returned %+v, expected %+v", flat.MethodConditions, expectedCondition)
+	}
+	condition := flat.MethodConditions[0]
+	value := condition.ConditionCriteria.Value
+	condition.ConditionCriteria.Value = nil
+	if !reflect.DeepEqual(condition, expectedCondition) || value == nil || !value.Equal(decimal.RequireFromString("0.5")) {
+		t.Errorf("DeliveryMethodDefinition.MethodConditions returned %+v, expected %+v with value 0.5", flat.MethodConditions, expectedCondition)
+	}
+
+	carrier := zone.MethodDefinitions[1].RateProvider
+	if carrier.Typename != DeliveryRateProviderTypeParticipant || carrier.CarrierService == nil ||
+		carrier.CarrierService.Id.Id != 770241334 || carrier.PercentageOfRateFee == nil || !carrier.PercentageOfRateFee.Equal(decimal.NewFromInt(10)) || !carrier.AdaptToNewServicesFlag {
+		t.Errorf("DeliveryMethodDefinition.RateProvider returned %+v", carrier)
+	}
+}
+
+func TestDeliveryProfileList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"deliveryProfiles":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"id":"gid://shopify/DeliveryProfile/1","name":"General profile","default":true}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"deliveryProfiles":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{"id":"gid://shopify/DeliveryProfile/2","name":"Oversized","default":false}]
+			}}}`), nil
+		},
+	)
+
+	profiles, err := client.DeliveryProfile.List(context.Background())
+	if err != nil {
+		t.Fatalf("DeliveryProfile.List returned error: %v", err)
+	}
+
+	expected := []DeliveryProfile{
+		{Id: NewGID(GIDResourceDeliveryProfile, 1), Name: "General profile", Default: true},
+		{Id: NewGID(GIDResourceDeliveryProfile, 2), Name: "Oversized"},
+	}
+	if !reflect.DeepEqual(profiles, expected) {
+		t.Errorf("DeliveryProfile.List returned %+v, expected %+v", profiles, expected)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("DeliveryProfile.List requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+}
+
+func TestDeliveryProfileGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var id string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			id = string(vars["id"])
+			return httpmock.NewBytesResponse(200, loadFixture("delivery_profile.json")), nil
+		},
+	)
+
+	profile, err := client.DeliveryProfile.Get(context.Background(), NewGID(GIDResourceDeliveryProfile, 690933843))
+	if err != nil {
+		t.Fatalf("DeliveryProfile.Get returned error: %v", err)
+	}
+
+	expectedId := `"gid://shopify/DeliveryProfile/690933843"`
+	if id != expectedId {
+		t.Errorf("DeliveryProfile.Get sent id %s, expected %s", id, expectedId)
+	}
+
+	deliveryProfileTests(t, *profile)
+}
+
+func TestDeliveryProfileCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query, input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			query, input = q, string(vars["profile"])
+			return httpmock.NewStringResponse(200, `{"data":{"deliveryProfileCreate":{
+				"profile":{"id":"gid://shopify/DeliveryProfile/3","name":"Oversized","default":false,"profileLocationGroups":[]},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	price := decimal.RequireFromString("25")
+	profile, err := client.DeliveryProfile.Create(context.Background(), DeliveryProfileInput{
+		Name: "Oversized",
+		ProfileLocationGroups: []DeliveryProfileLocationGroupInput{{
+			Locations: []GID{NewGID(GIDResourceLocation, 1)},
+			ZonesToCreate: []DeliveryLocationGroupZoneInput{{
+				Name:      "Domestic",
+				Countries: []DeliveryCountryInput{{Code: "US", IncludeAllProvinces: true}},
+				MethodDefinitionsToCreate: []DeliveryMethodDefinitionInput{{
+					Name:           "Freight",
+					RateDefinition: &DeliveryRateDefinitionInput{Price: MoneyV2{Amount: &price, CurrencyCode: "USD"}},
+					WeightConditionsToCreate: []DeliveryWeightConditionInput{{
+						Operator: DeliveryConditionOperatorGreaterThanOrEqualTo,
+						Criteria: Weight{Unit: WeightUnitKilograms, Value: decimal.NewFromInt(20)},
+					}},
+				}},
+			}},
+		}},
+		VariantsToAssociate: []GID{NewGID(GIDResourceProductVariant, 808950810)},
+	})
+	if err != nil {
+		t.Fatalf("DeliveryProfile.Create returned error: %v", err)
+	}
+
+	if GraphQLOperationName(query) != "deliveryProfileCreate" {
+		t.Errorf("DeliveryProfile.Create sent query %s", query)
+	}
+
+	expectedInput := `{"name":"Oversized","profileLocationGroups":[{"locations":["gid://shopify/Location/1"],"zonesToCreate":[{"name":"Domestic",` +
+		`"countries":[{"code":"US","includeAllProvinces":true}],"methodDefinitionsToCreate":[{"name":"Freight",` +
+		`"rateDefinition":{"price":{"amount":"25","currencyCode":"USD"}},` +
+		`"weightConditionsToCreate":[{"operator":"GREATER_THAN_OR_EQUAL_TO","criteria":{"unit":"KILOGRAMS","value":20}}]}]}]}],` +
+		`"variantsToAssociate":["gid://shopify/ProductVariant/808950810"]}`
+	if input != expectedInput {
+		t.Errorf("DeliveryProfile.Create sent %s, expected %s", input, expectedInput)
+	}
+
+	expected := &DeliveryProfile{Id: NewGID(GIDResourceDeliveryProfile, 3), Name: "Oversized", ProfileLocationGroups: []DeliveryProfileLocationGroup{}}
+	if !reflect.DeepEqual(profile, expected) {
+		t.Errorf("DeliveryProfile.Create returned %+v, expected %+v", profile, expected)
+	}
+}
+
+func TestDeliveryProfileUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var id, input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			id, input = string(vars["id"]), string(vars["profile"])
+			return httpmock.NewStringResponse(200, `{"data":{"deliveryProfileUpdate":{
+				"profile":{"id":"gid://shopify/DeliveryProfile/3","name":"Oversized"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	zoneId := NewGID(GIDResourceDeliveryZone, 972511399)
+	_, err := client.DeliveryProfile.Update(context.Background(), NewGID(GIDResourceDeliveryProfile, 3), DeliveryProfileInput{
+		ProfileLocationGroups: []DeliveryProfileLocationGroupInput{{
+			ZonesToUpdate: []DeliveryLocationGroupZoneInput{{Id: &zoneId, Name: "Lower 48"}},
+		}},
+		MethodDefinitionsToDelete: []GID{NewGID(GIDResourceDeliveryMethodDefinition, 882078075)},
+	})
+	if err != nil {
+		t.Fatalf("DeliveryProfile.Update returned error: %v", err)
+	}
+
+	expectedId := `"gid://shopify/DeliveryProfile/3"`
+	if id != expectedId {
+		t.Errorf("DeliveryProfile.Update sent id %s, expected %s", id, expectedId)
+	}
+
+	expectedInput := `{"profileLocationGroups":[{"zonesToUpdate":[{"id":"gid://shopify/DeliveryZone/972511399","name":"Lower 48"}]}],` +
+		`"methodDefinitionsToDelete":["gid://shopify/DeliveryMethodDefinition/882078075"]}`
+	if input != expectedInput {
+		t.Errorf("DeliveryProfile.Update sent %s, expected %s", input, expectedInput)
+	}
+}
+
+func TestDeliveryProfileUpdateUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"deliveryProfileUpdate":{
+			"profile":null,
+			"userErrors":[{"field":["profile","name"],"message":"Name can't be blank"}]
+		}}}`),
+	)
+
+	profile, err := client.DeliveryProfile.Update(context.Background(), NewGID(GIDResourceDeliveryProfile, 3), DeliveryProfileInput{})
+	if profile != nil {
+		t.Errorf("DeliveryProfile.Update returned %+v, expected nil", profile)
+	}
+
+	expectedErr := "profile.name: Name can't be blank"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("DeliveryProfile.Update returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestDeliveryProfileDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"deliveryProfileRemove":{
+			"job":{"id":"gid://shopify/Job/4d2e4b4f"},
+			"userErrors":[]
+		}}}`),
+	)
+
+	jobId, err := client.DeliveryProfile.Delete(context.Background(), NewGID(GIDResourceDeliveryProfile, 3))
+	if err != nil {
+		t.Fatalf("DeliveryProfile.Delete returned error: %v", err)
+	}
+
+	expected := GID{Resource: GIDResourceJob, Key: "4d2e4b4f"}
+	if !reflect.DeepEqual(jobId, expected) {
+		t.Errorf("DeliveryProfile.Delete returned %+v, expected %+v", jobId, expected)
+	}
+}
+
+func TestDeliveryProfileInputZeroValues(t *testing.T) {
+	zero := decimal.Zero
+	input := DeliveryMethodDefinitionInput{
+		Participant:              &DeliveryParticipantInput{PercentageOfRateFee: &zero},
+		WeightConditionsToCreate: []DeliveryWeightConditionInput{{Operator: DeliveryConditionOperatorGreaterThanOrEqualTo, Criteria: Weight{Unit: WeightUnitGrams}}},
+		ConditionsToUpdate:       []DeliveryUpdateConditionInput{{Id: NewGID(GIDResourceDeliveryCondition, 1), Criteria: &zero}},
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	expected := `{"participant":{"percentageOfRateFee":0},` +
+		`"weightConditionsToCreate":[{"operator":"GREATER_THAN_OR_EQUAL_TO","criteria":{"unit":"GRAMS","value":0}}],` +
+		`"conditionsToUpdate":[{"id":"gid://shopify/DeliveryCondition/1","criteria":0}]}`
+	if string(b) != expected {
+		t.Errorf("json.Marshal returned %s, expected %s", b, expected)
+	}
+}
@@ -6,7 +6,12 @@ import (
 	"time"
 )
 
-const discountCodeBasePath = "price_rules/%d/discount_codes"
+const (
+	discountCodeBasePath                 = "price_rules/%d/discount_codes"
+	discountCodeBatchBasePath            = "price_rules/%d/batch"
+	discountCodesBasePath                = "discount_codes"
+	defaultDiscountCodeBatchPollInterval = 2 * time.Second
+)
 
 // DiscountCodeService is an interface for interfacing with the discount endpoints
 // of the Shopify API.
@@ -17,6 +22,13 @@ type DiscountCodeService interface {
 	List(context.Context, uint64) ([]PriceRuleDiscountCode, error)
 	Get(context.Context, uint64, uint64) (*PriceRuleDiscountCode, error)
 	Delete(context.Context, uint64, uint64) error
+	ListWithPagination(context.Context, uint64, interface{}) ([]PriceRuleDiscountCode, *Pagination, error)
+	Lookup(context.Context, string) (*PriceRuleDiscountCode, error)
+	Count(context.Context, interface{}) (int, error)
+	CreateBatch(context.Context, uint64, []PriceRuleDiscountCode) (*DiscountCodeCreation, error)
+	GetBatch(context.Context, uint64, uint64) (*DiscountCodeCreation, error)
+	WaitBatch(context.Context, uint64, uint64, time.Duration) (*DiscountCodeCreation, error)
+	ListBatchCodes(context.Context, uint64, uint64) ([]PriceRuleDiscountCode, error)
 }
 
 // DiscountCodeServiceOp handles communication with the discount code
@@ -33,6 +45,47 @@ type PriceRuleDiscountCode struct {
 	UsageCount  int        `json:"usage_count,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Errors is only set on the codes of a batch, for codes that could not be
+	// created
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// DiscountCodeCountOptions represents the options available when counting
+// discount codes
+type DiscountCodeCountOptions struct {
+	TimesUsed    *int `url:"times_used,omitempty"`
+	TimesUsedMin *int `url:"times_used_min,omitempty"`
+	TimesUsedMax *int `url:"times_used_max,omitempty"`
+}
+
+type DiscountCodeCreationStatus string
+
+const (
+	DiscountCodeCreationStatusQueued    DiscountCodeCreationStatus = "queued"
+	DiscountCodeCreationStatusRunning   DiscountCodeCreationStatus = "running"
+	DiscountCodeCreationStatusCompleted DiscountCodeCreationStatus = "completed"
+)
+
+// DiscountCodeCreation represents an asynchronous job creating a batch of
+// discount codes
+type DiscountCodeCreation struct {
+	Id            uint64                     `json:"id,omitempty"`
+	PriceRuleId   uint64                     `json:"price_rule_id,omitempty"`
+	Status        DiscountCodeCreationStatus `json:"status,omitempty"`
+	CodesCount    int                        `json:"codes_count,omitempty"`
+	ImportedCount int                        `json:"imported_count,omitempty"`
+	FailedCount   int                        `json:"failed_count,omitempty"`
+	Logs          []string                   `json:"logs,omitempty"`
+	StartedAt     *time.Time                 `json:"started_at,omitempty"`
+	CompletedAt   *time.Time                 `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time                 `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time                 `json:"updated_at,omitempty"`
+}
+
+// DiscountCodeCreationResource represents the result from the price_rules/X/batch.json endpoint
+type DiscountCodeCreationResource struct {
+	DiscountCodeCreation *DiscountCodeCreation `json:"discount_code_creation"`
 }
 
 // DiscountCodesResource is the result from the discount_codes.json endpoint
@@ -71,6 +124,20 @@ func (s *DiscountCodeServiceOp) List(ctx context.Context, priceRuleId uint64) ([
 	return resource.DiscountCodes, err
 }
 
+// ListWithPagination lists the discount codes of a price rule and returns
+// pagination to retrieve next/previous results
+func (s *DiscountCodeServiceOp) ListWithPagination(ctx context.Context, priceRuleId uint64, options interface{}) ([]PriceRuleDiscountCode, *Pagination, error) {
+	path := fmt.Sprintf(discountCodeBasePath+".json", priceRuleId)
+	resource := new(DiscountCodesResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.DiscountCodes, pagination, nil
+}
+
 // Get a single discount code
 func (s *DiscountCodeServiceOp) Get(ctx context.Context, priceRuleId uint64, discountCodeId uint64) (*PriceRuleDiscountCode, error) {
 	path := fmt.Sprintf(discountCodeBasePath+"/%d.json", priceRuleId, discountCodeId)
@@ -83,3 +150,79 @@ func (s *DiscountCodeServiceOp) Get(ctx context.Context, priceRuleId uint64, dis
 func (s *DiscountCodeServiceOp) Delete(ctx context.Context, priceRuleId uint64, discountCodeId uint64) error {
 	return s.client.Delete(ctx, fmt.Sprintf(discountCodeBasePath+"/%d.json", priceRuleId, discountCodeId))
 }
+
+// Lookup retrieves a discount code by its code, regardless of its price rule
+func (s *DiscountCodeServiceOp) Lookup(ctx context.Context, code string) (*PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf("%s/lookup.json", discountCodesBasePath)
+	options := struct {
+		Code string `url:"code"`
+	}{code}
+	resource := new(DiscountCodeResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.PriceRuleDiscountCode, err
+}
+
+// Count the discount codes of the shop
+func (s *DiscountCodeServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", discountCodesBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// CreateBatch starts an asynchronous job creating up to 100 discount codes for
+// a price rule. Use WaitBatch to wait for it to complete.
+func (s *DiscountCodeServiceOp) CreateBatch(ctx context.Context, priceRuleId uint64, codes []PriceRuleDiscountCode) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+".json", priceRuleId)
+	wrappedData := DiscountCodesResource{DiscountCodes: codes}
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.DiscountCodeCreation, err
+}
+
+// GetBatch retrieves a discount code creation job
+func (s *DiscountCodeServiceOp) GetBatch(ctx context.Context, priceRuleId uint64, batchId uint64) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d.json", priceRuleId, batchId)
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.DiscountCodeCreation, err
+}
+
+// WaitBatch polls a discount code creation job every interval while it is
+// queued or running, until the context is done. The job is returned as soon
+// as it has any other status. An interval of 0 uses a default of 2 seconds.
+func (s *DiscountCodeServiceOp) WaitBatch(ctx context.Context, priceRuleId uint64, batchId uint64, interval time.Duration) (*DiscountCodeCreation, error) {
+	if interval <= 0 {
+		interval = defaultDiscountCodeBatchPollInterval
+	}
+
+	for {
+		batch, err := s.GetBatch(ctx, priceRuleId, batchId)
+		if err != nil {
+			return nil, err
+		}
+
+		if batch == nil {
+			return nil, fmt.Errorf("discount code batch %d not found in response", batchId)
+		}
+
+		if batch.Status != DiscountCodeCreationStatusQueued && batch.Status != DiscountCodeCreationStatusRunning {
+			return batch, nil
+		}
+
+		s.client.log.Debugf("discount code batch %d is %s, waiting %s", batchId, batch.Status, interval)
+
+		select {
+		case <-ctx.Done():
+			return batch, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ListBatchCodes lists the discount codes of a discount code creation job,
+// codes that could not be created have their Errors set
+func (s *DiscountCodeServiceOp) ListBatchCodes(ctx context.Context, priceRuleId uint64, batchId uint64) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d/discount_codes.json", priceRuleId, batchId)
+	resource := new(DiscountCodesResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.DiscountCodes, err
+}
@@ -3,7 +3,11 @@ package goshopify
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -126,3 +130,213 @@ func TestDiscountCodeDelete(t *testing.T) {
 		t.Errorf("DiscountCode.Delete returned error: %v", err)
 	}
 }
+
+func TestDiscountCodeListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/discount_codes.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, `{"discount_codes":[{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	codes, pagination, err := client.DiscountCode.ListWithPagination(context.Background(), 507328175, ListOptions{Limit: 2})
+	if err != nil {
+		t.Errorf("DiscountCode.ListWithPagination returned error: %v", err)
+	}
+
+	if len(codes) != 2 {
+		t.Errorf("DiscountCode.ListWithPagination returned %d codes, expected 2", len(codes))
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pg2", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("DiscountCode.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestDiscountCodeLookup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	codeURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/discount_codes/1054381139.json", client.pathPrefix)
+
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/discount_codes/lookup.json", client.pathPrefix),
+		map[string]string{"code": "SUMMERSALE10OFF"},
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(303, "")
+			resp.Header.Set("Location", codeURL)
+			return resp, nil
+		},
+	)
+	httpmock.RegisterResponder("GET", codeURL, httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	code, err := client.DiscountCode.Lookup(context.Background(), "SUMMERSALE10OFF")
+	if err != nil {
+		t.Fatalf("DiscountCode.Lookup returned error: %v", err)
+	}
+
+	if code.Id != 1054381139 || code.PriceRuleId != 507328175 || code.Code != "SUMMERSALE10OFF" {
+		t.Errorf("DiscountCode.Lookup returned %+v", code)
+	}
+}
+
+func TestDiscountCodeCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/discount_codes/count.json", client.pathPrefix),
+		map[string]string{"times_used_min": "1"},
+		httpmock.NewStringResponder(200, `{"count": 5}`),
+	)
+
+	timesUsedMin := 1
+	cnt, err := client.DiscountCode.Count(context.Background(), DiscountCodeCountOptions{TimesUsedMin: &timesUsedMin})
+	if err != nil {
+		t.Errorf("DiscountCode.Count returned error: %v", err)
+	}
+
+	expected := 5
+	if cnt != expected {
+		t.Errorf("DiscountCode.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestDiscountCodeCreateBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"discount_code_creation":{"id":989355119,"price_rule_id":507328175,"status":"queued","codes_count":2,"imported_count":0,"failed_count":0,"logs":[]}}`), nil
+		},
+	)
+
+	batch, err := client.DiscountCode.CreateBatch(context.Background(), 507328175, []PriceRuleDiscountCode{{Code: "SUMMER1"}, {Code: "SUMMER2"}})
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatch returned error: %v", err)
+	}
+
+	expectedBody := `{"discount_codes":[{"code":"SUMMER1"},{"code":"SUMMER2"}]}`
+	if body != expectedBody {
+		t.Errorf("DiscountCode.CreateBatch sent %s, expected %s", body, expectedBody)
+	}
+
+	expected := &DiscountCodeCreation{Id: 989355119, PriceRuleId: 507328175, Status: DiscountCodeCreationStatusQueued, CodesCount: 2, Logs: []string{}}
+	if !reflect.DeepEqual(batch, expected) {
+		t.Errorf("DiscountCode.CreateBatch returned %+v, expected %+v", batch, expected)
+	}
+}
+
+func TestDiscountCodeWaitBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			status := "running"
+			if calls > 1 {
+				status = "completed"
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"discount_code_creation":{"id":989355119,"status":"%s","codes_count":2,"imported_count":1,"failed_count":1}}`, status)), nil
+		},
+	)
+
+	batch, err := client.DiscountCode.WaitBatch(context.Background(), 507328175, 989355119, time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscountCode.WaitBatch returned error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("DiscountCode.WaitBatch polled %d times, expected 2", calls)
+	}
+
+	if batch.Status != DiscountCodeCreationStatusCompleted || batch.ImportedCount != 1 || batch.FailedCount != 1 {
+		t.Errorf("DiscountCode.WaitBatch returned %+v", batch)
+	}
+}
+
+func TestDiscountCodeWaitBatchUnexpectedStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"discount_code_creation":{"id":989355119,"status":"failed"}}`),
+	)
+
+	batch, err := client.DiscountCode.WaitBatch(context.Background(), 507328175, 989355119, time.Millisecond)
+	if err != nil {
+		t.Fatalf("DiscountCode.WaitBatch returned error: %v", err)
+	}
+
+	if batch.Status != "failed" {
+		t.Errorf("DiscountCode.WaitBatch returned %+v", batch)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 1 {
+		t.Errorf("DiscountCode.WaitBatch polled %d times, expected 1", calls)
+	}
+}
+
+func TestDiscountCodeWaitBatchMissing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{}`),
+	)
+
+	_, err := client.DiscountCode.WaitBatch(context.Background(), 507328175, 989355119, time.Millisecond)
+
+	expectedErr := "discount code batch 989355119 not found in response"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("DiscountCode.WaitBatch returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestDiscountCodeListBatchCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119/discount_codes.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"discount_codes":[
+			{"id":1,"code":"SUMMER1","errors":{}},
+			{"id":null,"code":"SUMMER2","errors":{"code":["must be unique. Please try a different code."]}}
+		]}`),
+	)
+
+	codes, err := client.DiscountCode.ListBatchCodes(context.Background(), 507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.ListBatchCodes returned error: %v", err)
+	}
+
+	expected := []PriceRuleDiscountCode{
+		{Id: 1, Code: "SUMMER1", Errors: map[string][]string{}},
+		{Code: "SUMMER2", Errors: map[string][]string{"code": {"must be unique. Please try a different code."}}},
+	}
+	if !reflect.DeepEqual(codes, expected) {
+		t.Errorf("DiscountCode.ListBatchCodes returned %+v, expected %+v", codes, expected)
+	}
+}
@@ -0,0 +1,280 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const disputesBasePath = "shopify_payments/disputes"
+
+// DisputeService is an interface for interfacing with the Shopify Payments
+// dispute endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/dispute
+type DisputeService interface {
+	List(context.Context, interface{}) ([]Dispute, error)
+	ListAll(context.Context, interface{}) ([]Dispute, error)
+	ListWithPagination(context.Context, interface{}) ([]Dispute, *Pagination, error)
+	Get(context.Context, uint64, interface{}) (*Dispute, error)
+	GetEvidence(context.Context, uint64) (*DisputeEvidence, error)
+	UpdateEvidence(context.Context, uint64, DisputeEvidence) (*DisputeEvidence, error)
+	UploadFile(context.Context, uint64, DisputeFileUpload) (*DisputeFileUpload, error)
+	DeleteFile(context.Context, uint64, uint64) error
+}
+
+// DisputeServiceOp handles communication with the dispute related methods of
+// the Shopify API.
+type DisputeServiceOp struct {
+	client *Client
+}
+
+// A struct for all available dispute list options
+type DisputeListOptions struct {
+	PageInfo    string        `url:"page_info,omitempty"`
+	Limit       int           `url:"limit,omitempty"`
+	LastId      uint64        `url:"last_id,omitempty"`
+	SinceId     uint64        `url:"since_id,omitempty"`
+	Status      DisputeStatus `url:"status,omitempty"`
+	InitiatedAt *OnlyDate     `url:"initiated_at,omitempty"`
+}
+
+// Dispute represents a Shopify Payments dispute
+type Dispute struct {
+	Id                uint64          `json:"id,omitempty"`
+	OrderId           uint64          `json:"order_id,omitempty"`
+	Type              DisputeType     `json:"type,omitempty"`
+	Amount            decimal.Decimal `json:"amount,omitempty"`
+	Currency          string          `json:"currency,omitempty"`
+	Reason            DisputeReason   `json:"reason,omitempty"`
+	NetworkReasonCode string          `json:"network_reason_code,omitempty"`
+	Status            DisputeStatus   `json:"status,omitempty"`
+	EvidenceDueBy     *time.Time      `json:"evidence_due_by,omitempty"`
+	EvidenceSentOn    *time.Time      `json:"evidence_sent_on,omitempty"`
+	FinalizedOn       *time.Time      `json:"finalized_on,omitempty"`
+	InitiatedAt       *time.Time      `json:"initiated_at,omitempty"`
+}
+
+type DisputeType string
+
+const (
+	DisputeTypeInquiry    DisputeType = "inquiry"
+	DisputeTypeChargeback DisputeType = "chargeback"
+)
+
+type DisputeStatus string
+
+const (
+	DisputeStatusNeedsResponse  DisputeStatus = "needs_response"
+	DisputeStatusUnderReview    DisputeStatus = "under_review"
+	DisputeStatusChargeRefunded DisputeStatus = "charge_refunded"
+	DisputeStatusAccepted       DisputeStatus = "accepted"
+	DisputeStatusWon            DisputeStatus = "won"
+	DisputeStatusLost           DisputeStatus = "lost"
+)
+
+type DisputeReason string
+
+const (
+	DisputeReasonBankCannotProcess    DisputeReason = "bank_cannot_process"
+	DisputeReasonCreditNotProcessed   DisputeReason = "credit_not_processed"
+	DisputeReasonCustomerInitiated    DisputeReason = "customer_initiated"
+	DisputeReasonDebitNotAuthorized   DisputeReason = "debit_not_authorized"
+	DisputeReasonDuplicate            DisputeReason = "duplicate"
+	DisputeReasonFraudulent           DisputeReason = "fraudulent"
+	DisputeReasonGeneral              DisputeReason = "general"
+	DisputeReasonIncorrectAccount     DisputeReason = "incorrect_account_details"
+	DisputeReasonInsufficientFunds    DisputeReason = "insufficient_funds"
+	DisputeReasonProductNotReceived   DisputeReason = "product_not_received"
+	DisputeReasonProductUnacceptable  DisputeReason = "product_unacceptable"
+	DisputeReasonSubscriptionCanceled DisputeReason = "subscription_canceled"
+	DisputeReasonUnrecognized         DisputeReason = "unrecognized"
+)
+
+// DisputeEvidence represents the evidence a merchant submits to contest a
+// dispute. Setting SubmitEvidence on update submits the evidence to the card
+// network, after which it can no longer be changed.
+type DisputeEvidence struct {
+	Id                           uint64                     `json:"id,omitempty"`
+	PaymentsDisputeId            uint64                     `json:"payments_dispute_id,omitempty"`
+	AccessActivityLog            string                     `json:"access_activity_log,omitempty"`
+	CancellationPolicyDisclosure string                     `json:"cancellation_policy_disclosure,omitempty"`
+	CancellationRebuttal         string                     `json:"cancellation_rebuttal,omitempty"`
+	CustomerEmailAddress         string                     `json:"customer_email_address,omitempty"`
+	CustomerFirstName            string                     `json:"customer_first_name,omitempty"`
+	CustomerLastName             string                     `json:"customer_last_name,omitempty"`
+	RefundPolicyDisclosure       string                     `json:"refund_policy_disclosure,omitempty"`
+	RefundRefusalExplanation     string                     `json:"refund_refusal_explanation,omitempty"`
+	UncategorizedText            string                     `json:"uncategorized_text,omitempty"`
+	ProductDescription           *DisputeProductDescription `json:"product_description,omitempty"`
+	BillingAddress               *Address                   `json:"billing_address,omitempty"`
+	ShippingAddress              *Address                   `json:"shipping_address,omitempty"`
+	Fulfillments                 []DisputeFulfillment       `json:"fulfillments,omitempty"`
+	DisputeEvidenceFiles         *DisputeEvidenceFiles      `json:"dispute_evidence_files,omitempty"`
+	SubmitEvidence               bool                       `json:"submit_evidence,omitempty"`
+	SubmittedByMerchantOn        *time.Time                 `json:"submitted_by_merchant_on,omitempty"`
+	CreatedAt                    *time.Time                 `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time                 `json:"updated_at,omitempty"`
+}
+
+// DisputeProductDescription describes the disputed product
+type DisputeProductDescription struct {
+	Title       string           `json:"title,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Price       *decimal.Decimal `json:"price,omitempty"`
+	Sku         string           `json:"sku,omitempty"`
+	Quantity    int              `json:"quantity,omitempty"`
+}
+
+// DisputeFulfillment describes a shipment of the disputed order
+type DisputeFulfillment struct {
+	ShippingCarrier        string    `json:"shipping_carrier,omitempty"`
+	ShippingTrackingNumber string    `json:"shipping_tracking_number,omitempty"`
+	ShippingDate           *OnlyDate `json:"shipping_date,omitempty"`
+}
+
+// DisputeEvidenceFiles holds the ids of the files uploaded as evidence
+type DisputeEvidenceFiles struct {
+	CancellationPolicyFileId    uint64 `json:"cancellation_policy_file_id,omitempty"`
+	CustomerCommunicationFileId uint64 `json:"customer_communication_file_id,omitempty"`
+	CustomerSignatureFileId     uint64 `json:"customer_signature_file_id,omitempty"`
+	RefundPolicyFileId          uint64 `json:"refund_policy_file_id,omitempty"`
+	ServiceDocumentationFileId  uint64 `json:"service_documentation_file_id,omitempty"`
+	ShippingDocumentationFileId uint64 `json:"shipping_documentation_file_id,omitempty"`
+	UncategorizedFileId         uint64 `json:"uncategorized_file_id,omitempty"`
+}
+
+// DisputeEvidenceDocumentType is the kind of evidence a file is uploaded as
+type DisputeEvidenceDocumentType string
+
+const (
+	DisputeEvidenceCancellationPolicyFile    DisputeEvidenceDocumentType = "cancellation_policy_file"
+	DisputeEvidenceCustomerCommunicationFile DisputeEvidenceDocumentType = "customer_communication_file"
+	DisputeEvidenceCustomerSignatureFile     DisputeEvidenceDocumentType = "customer_signature_file"
+	DisputeEvidenceRefundPolicyFile          DisputeEvidenceDocumentType = "refund_policy_file"
+	DisputeEvidenceServiceDocumentationFile  DisputeEvidenceDocumentType = "service_documentation_file"
+	DisputeEvidenceShippingDocumentationFile DisputeEvidenceDocumentType = "shipping_documentation_file"
+	DisputeEvidenceUncategorizedFile         DisputeEvidenceDocumentType = "uncategorized_file"
+)
+
+// DisputeFileUpload represents a file uploaded as dispute evidence. Data,
+// Filename and Mimetype are only sent on upload, Data is base64 encoded by
+// the json package.
+type DisputeFileUpload struct {
+	Id                  uint64                      `json:"id,omitempty"`
+	ShopId              uint64                      `json:"shop_id,omitempty"`
+	DocumentType        DisputeEvidenceDocumentType `json:"document_type,omitempty"`
+	Filename            string                      `json:"filename,omitempty"`
+	Mimetype            string                      `json:"mimetype,omitempty"`
+	Data                []byte                      `json:"data,omitempty"`
+	DisputeEvidenceId   uint64                      `json:"dispute_evidence_id,omitempty"`
+	DisputeEvidenceType DisputeEvidenceDocumentType `json:"dispute_evidence_type,omitempty"`
+	FileSize            int64                       `json:"file_size,omitempty"`
+	FileType            string                      `json:"file_type,omitempty"`
+	OriginalFilename    string                      `json:"original_filename,omitempty"`
+	Url                 string                      `json:"url,omitempty"`
+}
+
+// Represents the result from the disputes/X.json endpoint
+type DisputeResource struct {
+	Dispute *Dispute `json:"dispute"`
+}
+
+// Represents the result from the disputes.json endpoint
+type DisputesResource struct {
+	Disputes []Dispute `json:"disputes"`
+}
+
+// Represents the result from the disputes/X/dispute_evidences.json endpoint
+type DisputeEvidenceResource struct {
+	DisputeEvidence *DisputeEvidence `json:"dispute_evidence"`
+}
+
+// Represents the result from the disputes/X/dispute_file_uploads.json endpoint
+type DisputeFileUploadResource struct {
+	DisputeFileUpload *DisputeFileUpload `json:"dispute_file_upload"`
+}
+
+// List disputes
+func (s *DisputeServiceOp) List(ctx context.Context, options interface{}) ([]Dispute, error) {
+	disputes, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return disputes, nil
+}
+
+// ListAll Lists all disputes, iterating over pages
+func (s *DisputeServiceOp) ListAll(ctx context.Context, options interface{}) ([]Dispute, error) {
+	collector := []Dispute{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *DisputeServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]Dispute, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", disputesBasePath)
+	resource := new(DisputesResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Disputes, pagination, nil
+}
+
+// Get individual dispute
+func (s *DisputeServiceOp) Get(ctx context.Context, disputeId uint64, options interface{}) (*Dispute, error) {
+	path := fmt.Sprintf("%s/%d.json", disputesBasePath, disputeId)
+	resource := new(DisputeResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Dispute, err
+}
+
+// GetEvidence returns the evidence of a dispute
+func (s *DisputeServiceOp) GetEvidence(ctx context.Context, disputeId uint64) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeId)
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.DisputeEvidence, err
+}
+
+// UpdateEvidence updates the evidence of a dispute
+func (s *DisputeServiceOp) UpdateEvidence(ctx context.Context, disputeId uint64, evidence DisputeEvidence) (*DisputeEvidence, error) {
+	path := fmt.Sprintf("%s/%d/dispute_evidences.json", disputesBasePath, disputeId)
+	wrappedData := DisputeEvidenceResource{DisputeEvidence: &evidence}
+	resource := new(DisputeEvidenceResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.DisputeEvidence, err
+}
+
+// UploadFile uploads a file as evidence of a dispute
+func (s *DisputeServiceOp) UploadFile(ctx context.Context, disputeId uint64, file DisputeFileUpload) (*DisputeFileUpload, error) {
+	path := fmt.Sprintf("%s/%d/dispute_file_uploads.json", disputesBasePath, disputeId)
+	wrappedData := DisputeFileUploadResource{DisputeFileUpload: &file}
+	resource := new(DisputeFileUploadResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.DisputeFileUpload, err
+}
+
+// DeleteFile deletes a file uploaded as evidence of a dispute
+func (s *DisputeServiceOp) DeleteFile(ctx context.Context, disputeId, fileId uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("%s/%d/dispute_file_uploads/%d.json", disputesBasePath, disputeId, fileId))
+}
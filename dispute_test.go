@@ -0,0 +1,226 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func disputeTests(t *testing.T, dispute Dispute) {
+	expectedId := uint64(598735659)
+	if dispute.Id != expectedId {
+		t.Errorf("Dispute.Id returned %+v, expected %+v", dispute.Id, expectedId)
+	}
+
+	expectedOrderId := uint64(625362839)
+	if dispute.OrderId != expectedOrderId {
+		t.Errorf("Dispute.OrderId returned %+v, expected %+v", dispute.OrderId, expectedOrderId)
+	}
+
+	if dispute.Type != DisputeTypeChargeback || dispute.Reason != DisputeReasonFraudulent || dispute.Status != DisputeStatusNeedsResponse {
+		t.Errorf("Dispute returned type %s, reason %s, status %s", dispute.Type, dispute.Reason, dispute.Status)
+	}
+
+	expectedAmount := decimal.NewFromFloat(11.5)
+	if !dispute.Amount.Equal(expectedAmount) {
+		t.Errorf("Dispute.Amount returned %+v, expected %+v", dispute.Amount, expectedAmount)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedDueBy := time.Date(2024, time.March, 16, 20, 0, 0, 0, location)
+	if dispute.EvidenceDueBy == nil || !expectedDueBy.Equal(*dispute.EvidenceDueBy) {
+		t.Errorf("Dispute.EvidenceDueBy returned %+v, expected %+v", dispute.EvidenceDueBy, expectedDueBy)
+	}
+
+	if dispute.EvidenceSentOn != nil || dispute.FinalizedOn != nil {
+		t.Errorf("Dispute returned evidence sent on %+v, finalized on %+v, expected nil", dispute.EvidenceSentOn, dispute.FinalizedOn)
+	}
+}
+
+func TestDisputeList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"status": "needs_response"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes.json", client.pathPrefix),
+		params,
+		httpmock.NewBytesResponder(200, loadFixture("dispute/disputes.json")))
+
+	disputes, err := client.Dispute.List(context.Background(), DisputeListOptions{Status: DisputeStatusNeedsResponse})
+	if err != nil {
+		t.Fatalf("Dispute.List returned error: %v", err)
+	}
+
+	if len(disputes) != 2 {
+		t.Fatalf("Dispute.List returned %d disputes, expected 2", len(disputes))
+	}
+
+	disputeTests(t, disputes[0])
+
+	if disputes[1].Type != DisputeTypeInquiry || disputes[1].Status != DisputeStatusWon || disputes[1].FinalizedOn == nil {
+		t.Errorf("Dispute.List returned %+v", disputes[1])
+	}
+}
+
+func TestDisputeListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page_info") == "pg2" {
+			return httpmock.NewStringResponse(200, `{"disputes": [{"id":3}]}`), nil
+		}
+		resp := httpmock.NewStringResponse(200, `{"disputes": [{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	disputes, err := client.Dispute.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Dispute.ListAll returned error: %v", err)
+	}
+
+	expected := []Dispute{{Id: 1}, {Id: 2}, {Id: 3}}
+	if !reflect.DeepEqual(disputes, expected) {
+		t.Errorf("Dispute.ListAll returned %+v, expected %+v", disputes, expected)
+	}
+}
+
+func TestDisputeGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("dispute/dispute.json")))
+
+	dispute, err := client.Dispute.Get(context.Background(), 598735659, nil)
+	if err != nil {
+		t.Fatalf("Dispute.Get returned error: %v", err)
+	}
+
+	disputeTests(t, *dispute)
+}
+
+func TestDisputeGetEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("dispute/evidence.json")))
+
+	evidence, err := client.Dispute.GetEvidence(context.Background(), 598735659)
+	if err != nil {
+		t.Fatalf("Dispute.GetEvidence returned error: %v", err)
+	}
+
+	if evidence.Id != 819974671 || evidence.PaymentsDisputeId != 598735659 || evidence.CustomerEmailAddress != "bob@example.com" {
+		t.Errorf("Dispute.GetEvidence returned %+v", evidence)
+	}
+
+	price := decimal.NewFromFloat(199)
+	if evidence.ProductDescription == nil || evidence.ProductDescription.Price == nil || !evidence.ProductDescription.Price.Equal(price) {
+		t.Errorf("Dispute.GetEvidence returned product description %+v", evidence.ProductDescription)
+	}
+
+	if evidence.ShippingAddress == nil || evidence.ShippingAddress.ProvinceCode != "KY" {
+		t.Errorf("Dispute.GetEvidence returned shipping address %+v", evidence.ShippingAddress)
+	}
+
+	shippingDate := OnlyDate{time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)}
+	expectedFulfillments := []DisputeFulfillment{{ShippingCarrier: "UPS", ShippingTrackingNumber: "1Z1234512345123456", ShippingDate: &shippingDate}}
+	if !reflect.DeepEqual(evidence.Fulfillments, expectedFulfillments) {
+		t.Errorf("Dispute.GetEvidence returned fulfillments %+v, expected %+v", evidence.Fulfillments, expectedFulfillments)
+	}
+
+	expectedFiles := &DisputeEvidenceFiles{UncategorizedFileId: 539650252}
+	if !reflect.DeepEqual(evidence.DisputeEvidenceFiles, expectedFiles) {
+		t.Errorf("Dispute.GetEvidence returned files %+v, expected %+v", evidence.DisputeEvidenceFiles, expectedFiles)
+	}
+}
+
+func TestDisputeUpdateEvidence(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_evidences.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(200, loadFixture("dispute/evidence.json")), nil
+		})
+
+	evidence, err := client.Dispute.UpdateEvidence(context.Background(), 598735659, DisputeEvidence{
+		UncategorizedText: "Sample uncategorized text",
+		SubmitEvidence:    true,
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UpdateEvidence returned error: %v", err)
+	}
+
+	expectedBody := `{"dispute_evidence":{"uncategorized_text":"Sample uncategorized text","submit_evidence":true}}`
+	if body != expectedBody {
+		t.Errorf("Dispute.UpdateEvidence sent %s, expected %s", body, expectedBody)
+	}
+
+	if evidence.UncategorizedText != "Sample uncategorized text" {
+		t.Errorf("Dispute.UpdateEvidence returned %+v", evidence)
+	}
+}
+
+func TestDisputeUploadFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var sent DisputeFileUploadResource
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				return nil, err
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("dispute/file_upload.json")), nil
+		})
+
+	file, err := client.Dispute.UploadFile(context.Background(), 598735659, DisputeFileUpload{
+		DocumentType: DisputeEvidenceUncategorizedFile,
+		Filename:     "receipt.png",
+		Mimetype:     "image/png",
+		Data:         []byte("receipt data"),
+	})
+	if err != nil {
+		t.Fatalf("Dispute.UploadFile returned error: %v", err)
+	}
+
+	if sent.DisputeFileUpload == nil || string(sent.DisputeFileUpload.Data) != "receipt data" || sent.DisputeFileUpload.DocumentType != DisputeEvidenceUncategorizedFile {
+		t.Errorf("Dispute.UploadFile sent %+v", sent.DisputeFileUpload)
+	}
+
+	if file.Id != 539650252 || file.DisputeEvidenceType != DisputeEvidenceUncategorizedFile || file.OriginalFilename != "receipt.png" {
+		t.Errorf("Dispute.UploadFile returned %+v", file)
+	}
+}
+
+func TestDisputeDeleteFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/shopify_payments/disputes/598735659/dispute_file_uploads/539650252.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.Dispute.DeleteFile(context.Background(), 598735659, 539650252)
+	if err != nil {
+		t.Errorf("Dispute.DeleteFile returned error: %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const eventsBasePath = "events"
+
+// EventService is an interface for interfacing with the event endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/event
+type EventService interface {
+	List(context.Context, interface{}) ([]Event, error)
+	ListAll(context.Context, interface{}) ([]Event, error)
+	ListWithPagination(context.Context, interface{}) ([]Event, *Pagination, error)
+	Count(context.Context, interface{}) (int, error)
+	Get(context.Context, uint64, interface{}) (*Event, error)
+	ListByResource(context.Context, string, uint64, interface{}) ([]Event, error)
+	ListByResourceWithPagination(context.Context, string, uint64, interface{}) ([]Event, *Pagination, error)
+}
+
+// EventServiceOp handles communication with the event related methods of the
+// Shopify API.
+type EventServiceOp struct {
+	client *Client
+}
+
+// Event represents a Shopify event, i.e. an action taken on a resource of
+// the shop
+type Event struct {
+	Id          uint64        `json:"id,omitempty"`
+	SubjectId   uint64        `json:"subject_id,omitempty"`
+	SubjectType string        `json:"subject_type,omitempty"`
+	Verb        string        `json:"verb,omitempty"`
+	Arguments   []interface{} `json:"arguments,omitempty"`
+	Body        string        `json:"body,omitempty"`
+	Message     string        `json:"message,omitempty"`
+	Author      string        `json:"author,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Path        string        `json:"path,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+}
+
+// EventListOptions A struct for all available event list options.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/event#get-events
+type EventListOptions struct {
+	ListOptions
+
+	// Filter is a comma separated list of subject types, e.g. "Product,Order"
+	Filter string `url:"filter,omitempty"`
+
+	// Verb filters events by action, e.g. "create" or "destroy"
+	Verb string `url:"verb,omitempty"`
+}
+
+// EventResource represents the result from the events/X.json endpoint
+type EventResource struct {
+	Event *Event `json:"event"`
+}
+
+// EventsResource represents the result from the events.json endpoint
+type EventsResource struct {
+	Events []Event `json:"events"`
+}
+
+// List events
+func (s *EventServiceOp) List(ctx context.Context, options interface{}) ([]Event, error) {
+	events, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListAll Lists all events, iterating over pages
+func (s *EventServiceOp) ListAll(ctx context.Context, options interface{}) ([]Event, error) {
+	collector := []Event{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *EventServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]Event, *Pagination, error) {
+	return s.ListByResourceWithPagination(ctx, "", 0, options)
+}
+
+// Count events
+func (s *EventServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", eventsBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual event
+func (s *EventServiceOp) Get(ctx context.Context, eventId uint64, options interface{}) (*Event, error) {
+	path := fmt.Sprintf("%s/%d.json", eventsBasePath, eventId)
+	resource := new(EventResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Event, err
+}
+
+// ListByResource lists the events of a single resource, e.g. ("products", 123)
+// for products/123/events.json
+func (s *EventServiceOp) ListByResource(ctx context.Context, resource string, resourceId uint64, options interface{}) ([]Event, error) {
+	events, _, err := s.ListByResourceWithPagination(ctx, resource, resourceId, options)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (s *EventServiceOp) ListByResourceWithPagination(ctx context.Context, resource string, resourceId uint64, options interface{}) ([]Event, *Pagination, error) {
+	prefix := EventPathPrefix(resource, resourceId)
+	path := fmt.Sprintf("%s.json", prefix)
+	eventsResource := new(EventsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, eventsResource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return eventsResource.Events, pagination, nil
+}
@@ -0,0 +1,169 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func eventTests(t *testing.T, event Event) {
+	expectedId := uint64(677313116)
+	if event.Id != expectedId {
+		t.Errorf("Event.Id returned %+v, expected %+v", event.Id, expectedId)
+	}
+
+	expectedSubjectId := uint64(921728736)
+	if event.SubjectId != expectedSubjectId {
+		t.Errorf("Event.SubjectId returned %+v, expected %+v", event.SubjectId, expectedSubjectId)
+	}
+
+	expectedSubjectType := "Order"
+	if event.SubjectType != expectedSubjectType {
+		t.Errorf("Event.SubjectType returned %+v, expected %+v", event.SubjectType, expectedSubjectType)
+	}
+
+	expectedVerb := "confirmed"
+	if event.Verb != expectedVerb {
+		t.Errorf("Event.Verb returned %+v, expected %+v", event.Verb, expectedVerb)
+	}
+
+	expectedArguments := []interface{}{"#1002"}
+	if !reflect.DeepEqual(event.Arguments, expectedArguments) {
+		t.Errorf("Event.Arguments returned %+v, expected %+v", event.Arguments, expectedArguments)
+	}
+
+	expectedPath := "/admin/orders/921728736"
+	if event.Path != expectedPath {
+		t.Errorf("Event.Path returned %+v, expected %+v", event.Path, expectedPath)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedCreatedAt := time.Date(2024, time.January, 2, 9, 0, 0, 0, location)
+	if event.CreatedAt == nil || !expectedCreatedAt.Equal(*event.CreatedAt) {
+		t.Errorf("Event.CreatedAt returned %+v, expected %+v", event.CreatedAt, expectedCreatedAt)
+	}
+}
+
+func TestEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	events, err := client.Event.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Event.List returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Event.List returned %d events, expected 2", len(events))
+	}
+
+	if events[0].Id != 164748010 || events[1].Verb != "destroy" {
+		t.Errorf("Event.List returned %+v", events)
+	}
+}
+
+func TestEventListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"filter": "Product,Order", "verb": "create"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"events": [{"id":1},{"id":2}]}`))
+
+	events, err := client.Event.List(context.Background(), EventListOptions{Filter: "Product,Order", Verb: "create"})
+	if err != nil {
+		t.Errorf("Event.List returned error: %v", err)
+	}
+
+	expected := []Event{{Id: 1}, {Id: 2}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Event.List returned %+v, expected %+v", events, expected)
+	}
+}
+
+func TestEventListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/events.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page_info") == "pg2" {
+			return httpmock.NewStringResponse(200, `{"events": [{"id":3}]}`), nil
+		}
+		resp := httpmock.NewStringResponse(200, `{"events": [{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	events, err := client.Event.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Event.ListAll returned error: %v", err)
+	}
+
+	expected := []Event{{Id: 1}, {Id: 2}, {Id: 3}}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Event.ListAll returned %+v, expected %+v", events, expected)
+	}
+}
+
+func TestEventListByResource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/632910392/events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("events.json")))
+
+	events, err := client.Event.ListByResource(context.Background(), "products", 632910392, nil)
+	if err != nil {
+		t.Errorf("Event.ListByResource returned error: %v", err)
+	}
+
+	if len(events) != 2 || events[0].SubjectId != 632910392 {
+		t.Errorf("Event.ListByResource returned %+v", events)
+	}
+}
+
+func TestEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 3}`))
+
+	cnt, err := client.Event.Count(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Event.Count returned error: %v", err)
+	}
+
+	expected := 3
+	if cnt != expected {
+		t.Errorf("Event.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/events/677313116.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("event.json")))
+
+	event, err := client.Event.Get(context.Background(), 677313116, nil)
+	if err != nil {
+		t.Fatalf("Event.Get returned error: %v", err)
+	}
+
+	eventTests(t, *event)
+}
@@ -0,0 +1,491 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// FileService is an interface for uploading and creating files through the
+// graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/online-store/media/products
+type FileService interface {
+	StagedUploadsCreate(context.Context, []StagedUploadInput) ([]StagedUploadTarget, error)
+	StagedUpload(context.Context, StagedUploadInput, io.Reader) (*StagedUploadTarget, error)
+	Create(context.Context, []FileCreateInput) ([]File, error)
+	UploadFile(context.Context, io.Reader, string, string) (GID, error)
+	CreateProductMedia(context.Context, GID, []CreateMediaInput) ([]Media, error)
+	UploadProductMedia(context.Context, GID, io.Reader, string, string) (*Media, error)
+}
+
+// FileServiceOp handles communication with the file related methods of the
+// Shopify API.
+type FileServiceOp struct {
+	client *Client
+}
+
+// StagedUploadResource is the type of resource a staged upload is used for
+type StagedUploadResource string
+
+const (
+	StagedUploadResourceBulkMutationVariables StagedUploadResource = "BULK_MUTATION_VARIABLES"
+	StagedUploadResourceCollectionImage       StagedUploadResource = "COLLECTION_IMAGE"
+	StagedUploadResourceFile                  StagedUploadResource = "FILE"
+	StagedUploadResourceImage                 StagedUploadResource = "IMAGE"
+	StagedUploadResourceModel3d               StagedUploadResource = "MODEL_3D"
+	StagedUploadResourceProductImage          StagedUploadResource = "PRODUCT_IMAGE"
+	StagedUploadResourceShopImage             StagedUploadResource = "SHOP_IMAGE"
+	StagedUploadResourceUrlRedirectImport     StagedUploadResource = "URL_REDIRECT_IMPORT"
+	StagedUploadResourceVideo                 StagedUploadResource = "VIDEO"
+)
+
+// StagedUploadHttpMethod is the http method used to upload to a staged target
+type StagedUploadHttpMethod string
+
+const (
+	StagedUploadHttpMethodPost StagedUploadHttpMethod = "POST"
+	StagedUploadHttpMethodPut  StagedUploadHttpMethod = "PUT"
+)
+
+// FileContentType is the type of file created by fileCreate
+type FileContentType string
+
+const (
+	FileContentTypeFile          FileContentType = "FILE"
+	FileContentTypeImage         FileContentType = "IMAGE"
+	FileContentTypeVideo         FileContentType = "VIDEO"
+	FileContentTypeExternalVideo FileContentType = "EXTERNAL_VIDEO"
+	FileContentTypeModel3d       FileContentType = "MODEL_3D"
+)
+
+// FileStatus is the processing status of a file
+type FileStatus string
+
+const (
+	FileStatusUploaded   FileStatus = "UPLOADED"
+	FileStatusProcessing FileStatus = "PROCESSING"
+	FileStatusReady      FileStatus = "READY"
+	FileStatusFailed     FileStatus = "FAILED"
+)
+
+// MediaContentType is the type of media attached to a product
+type MediaContentType string
+
+const (
+	MediaContentTypeImage         MediaContentType = "IMAGE"
+	MediaContentTypeVideo         MediaContentType = "VIDEO"
+	MediaContentTypeExternalVideo MediaContentType = "EXTERNAL_VIDEO"
+	MediaContentTypeModel3d       MediaContentType = "MODEL_3D"
+)
+
+// MediaStatus is the processing status of a product media
+type MediaStatus string
+
+const (
+	MediaStatusUploaded   MediaStatus = "UPLOADED"
+	MediaStatusProcessing MediaStatus = "PROCESSING"
+	MediaStatusReady      MediaStatus = "READY"
+	MediaStatusFailed     MediaStatus = "FAILED"
+)
+
+// StagedUploadInput represents the input of the stagedUploadsCreate mutation
+type StagedUploadInput struct {
+	Resource   StagedUploadResource   `json:"resource"`
+	Filename   string                 `json:"filename"`
+	MimeType   string                 `json:"mimeType"`
+	HttpMethod StagedUploadHttpMethod `json:"httpMethod,omitempty"`
+	FileSize   uint64                 `json:"fileSize,omitempty,string"`
+}
+
+// StagedUploadTarget represents the url and form parameters a file must be
+// uploaded to before it can be used in another mutation
+type StagedUploadTarget struct {
+	Url         string                        `json:"url"`
+	ResourceUrl string                        `json:"resourceUrl"`
+	Parameters  []StagedUploadTargetParameter `json:"parameters"`
+}
+
+// StagedUploadTargetParameter represents a form parameter of a staged upload
+type StagedUploadTargetParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// FileCreateInput represents the input of the fileCreate mutation
+type FileCreateInput struct {
+	OriginalSource string          `json:"originalSource"`
+	ContentType    FileContentType `json:"contentType,omitempty"`
+	Alt            string          `json:"alt,omitempty"`
+	Filename       string          `json:"filename,omitempty"`
+}
+
+// File represents a Shopify file
+type File struct {
+	Id         GID         `json:"id"`
+	Typename   string      `json:"__typename,omitempty"`
+	Alt        string      `json:"alt,omitempty"`
+	FileStatus FileStatus  `json:"fileStatus,omitempty"`
+	FileErrors []FileError `json:"fileErrors,omitempty"`
+	CreatedAt  *time.Time  `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time  `json:"updatedAt,omitempty"`
+}
+
+// FileError represents an error that occurred while processing a file
+type FileError struct {
+	Code    string `json:"code,omitempty"`
+	Details string `json:"details,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// CreateMediaInput represents the input of the productCreateMedia mutation
+type CreateMediaInput struct {
+	OriginalSource   string           `json:"originalSource"`
+	MediaContentType MediaContentType `json:"mediaContentType"`
+	Alt              string           `json:"alt,omitempty"`
+}
+
+// Media represents a media attached to a product
+type Media struct {
+	Id               GID              `json:"id"`
+	Typename         string           `json:"__typename,omitempty"`
+	Alt              string           `json:"alt,omitempty"`
+	MediaContentType MediaContentType `json:"mediaContentType,omitempty"`
+	Status           MediaStatus      `json:"status,omitempty"`
+	MediaErrors      []FileError      `json:"mediaErrors,omitempty"`
+}
+
+const stagedUploadsCreateMutation = `mutation stagedUploadsCreate($input: [StagedUploadInput!]!) {
+  stagedUploadsCreate(input: $input) {
+    stagedTargets {
+      url
+      resourceUrl
+      parameters {
+        name
+        value
+      }
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const fileCreateMutation = `mutation fileCreate($files: [FileCreateInput!]!) {
+  fileCreate(files: $files) {
+    files {
+      __typename
+      id
+      alt
+      fileStatus
+      fileErrors {
+        code
+        details
+        message
+      }
+      createdAt
+      updatedAt
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const productCreateMediaMutation = `mutation productCreateMedia($productId: ID!, $media: [CreateMediaInput!]!) {
+  productCreateMedia(productId: $productId, media: $media) {
+    media {
+      __typename
+      id
+      alt
+      mediaContentType
+      status
+      mediaErrors {
+        code
+        details
+        message
+      }
+    }
+    mediaUserErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// StagedUploadsCreate creates the targets files can be uploaded to
+func (s *FileServiceOp) StagedUploadsCreate(ctx context.Context, input []StagedUploadInput) ([]StagedUploadTarget, error) {
+	vars := map[string]interface{}{"input": input}
+	resp := struct {
+		StagedUploadsCreate struct {
+			StagedTargets []StagedUploadTarget `json:"stagedTargets"`
+			UserErrors    []GraphQLUserError   `json:"userErrors"`
+		} `json:"stagedUploadsCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, stagedUploadsCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.StagedUploadsCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.StagedUploadsCreate.StagedTargets, nil
+}
+
+// StagedUpload creates a staged upload target and uploads the content of r
+// to it. The returned target's ResourceUrl can be used as the source of
+// other mutations, e.g. fileCreate or productCreateMedia.
+func (s *FileServiceOp) StagedUpload(ctx context.Context, input StagedUploadInput, r io.Reader) (*StagedUploadTarget, error) {
+	if input.HttpMethod == "" {
+		input.HttpMethod = StagedUploadHttpMethodPost
+	}
+
+	targets, err := s.StagedUploadsCreate(ctx, []StagedUploadInput{input})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targets) == 0 {
+		return nil, ResponseDecodingError{Message: "stagedUploadsCreate returned no targets"}
+	}
+
+	target := targets[0]
+	err = s.upload(ctx, target, input, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &target, nil
+}
+
+// Create creates files from previously uploaded or external sources
+func (s *FileServiceOp) Create(ctx context.Context, files []FileCreateInput) ([]File, error) {
+	vars := map[string]interface{}{"files": files}
+	resp := struct {
+		FileCreate struct {
+			Files      []File             `json:"files"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"fileCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, fileCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.FileCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.FileCreate.Files, nil
+}
+
+// UploadFile uploads the content of r using the staged upload protocol and
+// creates a file from it, returning the id of the created file.
+// The content is buffered in memory as Shopify requires the file size up front.
+func (s *FileServiceOp) UploadFile(ctx context.Context, r io.Reader, filename, mimeType string) (GID, error) {
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return GID{}, err
+	}
+
+	resource, contentType := fileTypesFromMimeType(mimeType)
+	input := StagedUploadInput{
+		Resource:   resource,
+		Filename:   filename,
+		MimeType:   mimeType,
+		HttpMethod: StagedUploadHttpMethodPost,
+		FileSize:   uint64(len(content)),
+	}
+
+	target, err := s.StagedUpload(ctx, input, bytes.NewReader(content))
+	if err != nil {
+		return GID{}, err
+	}
+
+	files, err := s.Create(ctx, []FileCreateInput{{
+		OriginalSource: target.ResourceUrl,
+		ContentType:    contentType,
+		Filename:       filename,
+	}})
+	if err != nil {
+		return GID{}, err
+	}
+
+	if len(files) == 0 {
+		return GID{}, ResponseDecodingError{Message: "fileCreate returned no files"}
+	}
+
+	return files[0].Id, nil
+}
+
+// CreateProductMedia attaches media from previously uploaded or external
+// sources to a product
+func (s *FileServiceOp) CreateProductMedia(ctx context.Context, productId GID, media []CreateMediaInput) ([]Media, error) {
+	if err := productId.Validate(GIDResourceProduct); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{"productId": productId, "media": media}
+	resp := struct {
+		ProductCreateMedia struct {
+			Media           []Media            `json:"media"`
+			MediaUserErrors []GraphQLUserError `json:"mediaUserErrors"`
+		} `json:"productCreateMedia"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, productCreateMediaMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.ProductCreateMedia.MediaUserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.ProductCreateMedia.Media, nil
+}
+
+// UploadProductMedia uploads the content of r using the staged upload
+// protocol and attaches it to a product. Only image, video and 3d model mime
+// types are supported. The content is buffered in memory as Shopify requires
+// the file size up front.
+func (s *FileServiceOp) UploadProductMedia(ctx context.Context, productId GID, r io.Reader, filename, mimeType string) (*Media, error) {
+	if err := productId.Validate(GIDResourceProduct); err != nil {
+		return nil, err
+	}
+
+	resource, contentType := fileTypesFromMimeType(mimeType)
+	if contentType == FileContentTypeFile {
+		return nil, fmt.Errorf("unsupported product media mime type %q", mimeType)
+	}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	input := StagedUploadInput{
+		Resource:   resource,
+		Filename:   filename,
+		MimeType:   mimeType,
+		HttpMethod: StagedUploadHttpMethodPost,
+		FileSize:   uint64(len(content)),
+	}
+
+	target, err := s.StagedUpload(ctx, input, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := s.CreateProductMedia(ctx, productId, []CreateMediaInput{{
+		OriginalSource:   target.ResourceUrl,
+		MediaContentType: MediaContentType(contentType),
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(media) == 0 {
+		return nil, ResponseDecodingError{Message: "productCreateMedia returned no media"}
+	}
+
+	return &media[0], nil
+}
+
+// upload sends the content of r to a staged upload target. POST targets
+// expect a multipart form with the target parameters followed by the file,
+// PUT targets expect the raw content with the parameters as headers.
+func (s *FileServiceOp) upload(ctx context.Context, target StagedUploadTarget, input StagedUploadInput, r io.Reader) error {
+	var body bytes.Buffer
+	header := http.Header{}
+
+	if input.HttpMethod == StagedUploadHttpMethodPut {
+		_, err := io.Copy(&body, r)
+		if err != nil {
+			return err
+		}
+
+		for _, param := range target.Parameters {
+			header.Set(param.Name, param.Value)
+		}
+	} else {
+		writer := multipart.NewWriter(&body)
+		for _, param := range target.Parameters {
+			err := writer.WriteField(param.Name, param.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		part, err := writer.CreateFormFile("file", input.Filename)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(part, r)
+		if err != nil {
+			return err
+		}
+
+		err = writer.Close()
+		if err != nil {
+			return err
+		}
+
+		header.Set("Content-Type", writer.FormDataContentType())
+	}
+
+	req, err := http.NewRequest(string(input.HttpMethod), target.Url, &body)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header = header
+	req.Header.Set("User-Agent", UserAgent)
+
+	s.client.log.Debugf("%s: %s", req.Method, req.URL.String())
+	resp, err := s.client.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return ResponseError{
+			Status:  resp.StatusCode,
+			Message: fmt.Sprintf("staged upload failed: %s", strings.TrimSpace(string(respBody))),
+		}
+	}
+
+	return nil
+}
+
+// fileTypesFromMimeType returns the staged upload resource and file content
+// type matching a mime type
+func fileTypesFromMimeType(mimeType string) (StagedUploadResource, FileContentType) {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return StagedUploadResourceImage, FileContentTypeImage
+	case strings.HasPrefix(mimeType, "video/"):
+		return StagedUploadResourceVideo, FileContentTypeVideo
+	case strings.HasPrefix(mimeType, "model/"):
+		return StagedUploadResourceModel3d, FileContentTypeModel3d
+	default:
+		return StagedUploadResourceFile, FileContentTypeFile
+	}
+}
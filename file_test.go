@@ -0,0 +1,333 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+const testStagedUploadUrl = "https://shopify-staged-uploads.storage.googleapis.com/"
+
+func graphQLRequestBody(req *http.Request) (string, map[string]json.RawMessage) {
+	data := struct {
+		Query     string                     `json:"query"`
+		Variables map[string]json.RawMessage `json:"variables"`
+	}{}
+	b, _ := ioutil.ReadAll(req.Body)
+	_ = json.Unmarshal(b, &data)
+	return data.Query, data.Variables
+}
+
+func TestFileUploadFile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var stagedInput, createInput string
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			switch {
+			case strings.Contains(q, "stagedUploadsCreate"):
+				stagedInput = string(vars["input"])
+				return httpmock.NewStringResponse(200, `{"data":{"stagedUploadsCreate":{
+					"stagedTargets":[{
+						"url":"`+testStagedUploadUrl+`",
+						"resourceUrl":"https://shopify-staged-uploads.storage.googleapis.com/tmp/1/image.png",
+						"parameters":[{"name":"key","value":"tmp/1/image.png"},{"name":"policy","value":"abc"}]
+					}],
+					"userErrors":[]
+				}}}`), nil
+			case strings.Contains(q, "fileCreate"):
+				createInput = string(vars["files"])
+				return httpmock.NewStringResponse(200, `{"data":{"fileCreate":{
+					"files":[{"__typename":"MediaImage","id":"gid://shopify/MediaImage/1","fileStatus":"UPLOADED"}],
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(400, ""), nil
+		},
+	)
+
+	var uploadKey, uploadContent string
+	httpmock.RegisterResponder(
+		"POST",
+		testStagedUploadUrl,
+		func(req *http.Request) (*http.Response, error) {
+			err := req.ParseMultipartForm(1024)
+			if err != nil {
+				return httpmock.NewStringResponse(400, err.Error()), nil
+			}
+			uploadKey = req.FormValue("key")
+			f, _, err := req.FormFile("file")
+			if err != nil {
+				return httpmock.NewStringResponse(400, err.Error()), nil
+			}
+			b, _ := ioutil.ReadAll(f)
+			uploadContent = string(b)
+			return httpmock.NewStringResponse(204, ""), nil
+		},
+	)
+
+	gid, err := client.File.UploadFile(context.Background(), strings.NewReader("png-bytes"), "image.png", "image/png")
+	if err != nil {
+		t.Fatalf("File.UploadFile returned error: %v", err)
+	}
+
+	expectedGID := NewGID(GIDResourceMediaImage, 1)
+	if gid.String() != expectedGID.String() {
+		t.Errorf("File.UploadFile returned %s, expected %s", gid, expectedGID)
+	}
+
+	expectedStagedInput := `[{"resource":"IMAGE","filename":"image.png","mimeType":"image/png","httpMethod":"POST","fileSize":"9"}]`
+	if stagedInput != expectedStagedInput {
+		t.Errorf("stagedUploadsCreate input %s, expected %s", stagedInput, expectedStagedInput)
+	}
+
+	if uploadKey != "tmp/1/image.png" {
+		t.Errorf("staged upload key %s, expected %s", uploadKey, "tmp/1/image.png")
+	}
+
+	if uploadContent != "png-bytes" {
+		t.Errorf("staged upload content %s, expected %s", uploadContent, "png-bytes")
+	}
+
+	expectedCreateInput := `[{"originalSource":"https://shopify-staged-uploads.storage.googleapis.com/tmp/1/image.png","contentType":"IMAGE","filename":"image.png"}]`
+	if createInput != expectedCreateInput {
+		t.Errorf("fileCreate input %s, expected %s", createInput, expectedCreateInput)
+	}
+}
+
+func TestFileStagedUploadPut(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"stagedUploadsCreate":{
+			"stagedTargets":[{
+				"url":"`+testStagedUploadUrl+`tmp/1/file.txt",
+				"resourceUrl":"`+testStagedUploadUrl+`tmp/1/file.txt",
+				"parameters":[{"name":"content_type","value":"text/plain"},{"name":"x-goog-acl","value":"private"}]
+			}],
+			"userErrors":[]
+		}}}`),
+	)
+
+	var acl, content string
+	httpmock.RegisterResponder(
+		"PUT",
+		testStagedUploadUrl+"tmp/1/file.txt",
+		func(req *http.Request) (*http.Response, error) {
+			acl = req.Header.Get("x-goog-acl")
+			b, _ := ioutil.ReadAll(req.Body)
+			content = string(b)
+			return httpmock.NewStringResponse(200, ""), nil
+		},
+	)
+
+	input := StagedUploadInput{
+		Resource:   StagedUploadResourceFile,
+		Filename:   "file.txt",
+		MimeType:   "text/plain",
+		HttpMethod: StagedUploadHttpMethodPut,
+	}
+	target, err := client.File.StagedUpload(context.Background(), input, strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("File.StagedUpload returned error: %v", err)
+	}
+
+	if target.ResourceUrl != testStagedUploadUrl+"tmp/1/file.txt" {
+		t.Errorf("File.StagedUpload returned resource url %s", target.ResourceUrl)
+	}
+
+	if acl != "private" || content != "hello" {
+		t.Errorf("File.StagedUpload sent acl %s and content %s", acl, content)
+	}
+}
+
+func TestFileStagedUploadFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"stagedUploadsCreate":{
+			"stagedTargets":[{"url":"`+testStagedUploadUrl+`","resourceUrl":"","parameters":[]}],
+			"userErrors":[]
+		}}}`),
+	)
+
+	httpmock.RegisterResponder(
+		"POST",
+		testStagedUploadUrl,
+		httpmock.NewStringResponder(403, "<Error>AccessDenied</Error>"),
+	)
+
+	_, err := client.File.UploadFile(context.Background(), strings.NewReader("x"), "file.pdf", "application/pdf")
+	expected := ResponseError{Status: 403, Message: "staged upload failed: <Error>AccessDenied</Error>"}
+	if err == nil || err.Error() != expected.Error() {
+		t.Errorf("File.UploadFile returned error %v, expected %v", err, expected)
+	}
+}
+
+func TestFileCreateUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"fileCreate":{
+			"files":[],
+			"userErrors":[{"field":["files","0","originalSource"],"message":"Invalid source","code":"INVALID"}]
+		}}}`),
+	)
+
+	_, err := client.File.Create(context.Background(), []FileCreateInput{{OriginalSource: "foo"}})
+	expected := "files.0.originalSource: Invalid source"
+	if err == nil || err.Error() != expected {
+		t.Errorf("File.Create returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestFileUploadProductMedia(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var stagedInput, productId, mediaInput string
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			switch {
+			case strings.Contains(q, "stagedUploadsCreate"):
+				stagedInput = string(vars["input"])
+				return httpmock.NewStringResponse(200, `{"data":{"stagedUploadsCreate":{
+					"stagedTargets":[{
+						"url":"`+testStagedUploadUrl+`",
+						"resourceUrl":"https://shopify-staged-uploads.storage.googleapis.com/tmp/1/image.png",
+						"parameters":[{"name":"key","value":"tmp/1/image.png"}]
+					}],
+					"userErrors":[]
+				}}}`), nil
+			case strings.Contains(q, "productCreateMedia"):
+				productId = string(vars["productId"])
+				mediaInput = string(vars["media"])
+				return httpmock.NewStringResponse(200, `{"data":{"productCreateMedia":{
+					"media":[{"__typename":"MediaImage","id":"gid://shopify/MediaImage/1","mediaContentType":"IMAGE","status":"UPLOADED","mediaErrors":[]}],
+					"mediaUserErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(400, ""), nil
+		},
+	)
+
+	var uploadContent string
+	httpmock.RegisterResponder(
+		"POST",
+		testStagedUploadUrl,
+		func(req *http.Request) (*http.Response, error) {
+			err := req.ParseMultipartForm(1024)
+			if err != nil {
+				return httpmock.NewStringResponse(400, err.Error()), nil
+			}
+			f, _, err := req.FormFile("file")
+			if err != nil {
+				return httpmock.NewStringResponse(400, err.Error()), nil
+			}
+			b, _ := ioutil.ReadAll(f)
+			uploadContent = string(b)
+			return httpmock.NewStringResponse(204, ""), nil
+		},
+	)
+
+	product := NewGID(GIDResourceProduct, 1)
+	media, err := client.File.UploadProductMedia(context.Background(), product, strings.NewReader("png-bytes"), "image.png", "image/png")
+	if err != nil {
+		t.Fatalf("File.UploadProductMedia returned error: %v", err)
+	}
+
+	expectedGID := NewGID(GIDResourceMediaImage, 1)
+	if media.Id.String() != expectedGID.String() || media.Status != MediaStatusUploaded || media.MediaContentType != MediaContentTypeImage {
+		t.Errorf("File.UploadProductMedia returned %+v, expected uploaded image %s", media, expectedGID)
+	}
+
+	expectedStagedInput := `[{"resource":"IMAGE","filename":"image.png","mimeType":"image/png","httpMethod":"POST","fileSize":"9"}]`
+	if stagedInput != expectedStagedInput {
+		t.Errorf("stagedUploadsCreate input %s, expected %s", stagedInput, expectedStagedInput)
+	}
+
+	if uploadContent != "png-bytes" {
+		t.Errorf("staged upload content %s, expected %s", uploadContent, "png-bytes")
+	}
+
+	if productId != `"gid://shopify/Product/1"` {
+		t.Errorf("productCreateMedia productId %s, expected %s", productId, `"gid://shopify/Product/1"`)
+	}
+
+	expectedMediaInput := `[{"originalSource":"https://shopify-staged-uploads.storage.googleapis.com/tmp/1/image.png","mediaContentType":"IMAGE"}]`
+	if mediaInput != expectedMediaInput {
+		t.Errorf("productCreateMedia media %s, expected %s", mediaInput, expectedMediaInput)
+	}
+}
+
+func TestFileUploadProductMediaUnsupportedMimeType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	product := NewGID(GIDResourceProduct, 1)
+	_, err := client.File.UploadProductMedia(context.Background(), product, strings.NewReader("%PDF"), "manual.pdf", "application/pdf")
+	expected := `unsupported product media mime type "application/pdf"`
+	if err == nil || err.Error() != expected {
+		t.Errorf("File.UploadProductMedia returned error %v, expected %s", err, expected)
+	}
+
+	if httpmock.GetTotalCallCount() != 0 {
+		t.Errorf("File.UploadProductMedia sent %d requests, expected none", httpmock.GetTotalCallCount())
+	}
+}
+
+func TestFileCreateProductMediaUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"productCreateMedia":{
+			"media":[],
+			"mediaUserErrors":[{"field":["media","0","originalSource"],"message":"Image URL is invalid","code":"INVALID"}]
+		}}}`),
+	)
+
+	product := NewGID(GIDResourceProduct, 1)
+	_, err := client.File.CreateProductMedia(context.Background(), product, []CreateMediaInput{{OriginalSource: "foo", MediaContentType: MediaContentTypeImage}})
+	expected := "media.0.originalSource: Image URL is invalid"
+	if err == nil || err.Error() != expected {
+		t.Errorf("File.CreateProductMedia returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestFileCreateProductMediaInvalidProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.File.CreateProductMedia(context.Background(), NewGID(GIDResourceOrder, 1), nil)
+	expected := `gid "gid://shopify/Order/1" is a Order, expected Product`
+	if err == nil || err.Error() != expected {
+		t.Errorf("File.CreateProductMedia returned error %v, expected %s", err, expected)
+	}
+}
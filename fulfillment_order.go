@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -20,6 +21,9 @@ type FulfillmentOrderService interface {
 	Reschedule(context.Context, uint64) (*FulfillmentOrder, error)
 	SetDeadline(context.Context, []uint64, time.Time) error
 	Move(context.Context, uint64, FulfillmentOrderMoveRequest) (*FulfillmentOrderMoveResource, error)
+	LocationsForMove(context.Context, uint64) ([]FulfillmentOrderLocationForMove, error)
+	Split(context.Context, uint64, []FulfillmentOrderLineItemQuantity) (*FulfillmentOrderSplitResult, error)
+	Merge(context.Context, []uint64) (uint64, error)
 }
 
 // FulfillmentOrderHoldReason represents the reason for a fulfillment hold
@@ -49,6 +53,27 @@ type FulfillmentOrderMoveRequest struct {
 	LineItems     []FulfillmentOrderLineItemQuantity `json:"fulfillment_order_line_items,omitempty"`
 }
 
+// FulfillmentOrderLocationForMove represents a location a fulfillment order
+// can or cannot be moved to, Message explains why it is not movable
+type FulfillmentOrderLocationForMove struct {
+	Location struct {
+		Id   uint64 `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"location"`
+	Message string `json:"message,omitempty"`
+	Movable bool   `json:"movable"`
+}
+
+// FulfillmentOrderSplitResult represents the fulfillment orders resulting
+// from a split. The split line items are moved to RemainingFulfillmentOrderId,
+// ReplacementFulfillmentOrderId is only set when the original fulfillment
+// order could not be kept.
+type FulfillmentOrderSplitResult struct {
+	FulfillmentOrderId            uint64
+	RemainingFulfillmentOrderId   uint64
+	ReplacementFulfillmentOrderId uint64
+}
+
 // FulfillmentOrderDeliveryMethod represents a delivery method for a FulfillmentOrder
 type FulfillmentOrderDeliveryMethod struct {
 	Id                  uint64    `json:"id,omitempty"`
@@ -158,6 +183,11 @@ type FulfillmentOrderMoveResource struct {
 	MovedFulfillmentOrder    FulfillmentOrder `json:"moved_fulfillment_order"`
 }
 
+// FulfillmentOrderLocationsForMoveResource represents the result from the locations_for_move.json endpoint
+type FulfillmentOrderLocationsForMoveResource struct {
+	LocationsForMove []FulfillmentOrderLocationForMove `json:"locations_for_move"`
+}
+
 // FulfillmentOrderPathPrefix returns the prefix for a fulfillmentOrder path
 func FulfillmentOrderPathPrefix(resource string, resourceId uint64) string {
 	return fmt.Sprintf("%s/%d", resource, resourceId)
@@ -282,3 +312,143 @@ func (s *FulfillmentOrderServiceOp) Move(ctx context.Context, fulfillmentId uint
 	err := s.client.Post(ctx, path, wrappedRequest, resource)
 	return resource, err
 }
+
+// LocationsForMove lists the locations a fulfillment order can be moved to
+func (s *FulfillmentOrderServiceOp) LocationsForMove(ctx context.Context, fulfillmentId uint64) ([]FulfillmentOrderLocationForMove, error) {
+	prefix := FulfillmentOrderPathPrefix("fulfillment_orders", fulfillmentId)
+	path := fmt.Sprintf("%s/locations_for_move.json", prefix)
+	resource := new(FulfillmentOrderLocationsForMoveResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.LocationsForMove, err
+}
+
+// fulfillmentOrderLineItemInput represents a fulfillment order line item of
+// the split and merge mutations
+type fulfillmentOrderLineItemInput struct {
+	Id       GID    `json:"id"`
+	Quantity uint64 `json:"quantity"`
+}
+
+type fulfillmentOrderNode struct {
+	Id GID `json:"id"`
+}
+
+func (n *fulfillmentOrderNode) restId() uint64 {
+	if n == nil {
+		return 0
+	}
+	return n.Id.Id
+}
+
+const fulfillmentOrderSplitMutation = `mutation fulfillmentOrderSplit($fulfillmentOrderSplits: [FulfillmentOrderSplitInput!]!) {
+  fulfillmentOrderSplit(fulfillmentOrderSplits: $fulfillmentOrderSplits) {
+    fulfillmentOrderSplits {
+      fulfillmentOrder { id }
+      remainingFulfillmentOrder { id }
+      replacementFulfillmentOrder { id }
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const fulfillmentOrderMergeMutation = `mutation fulfillmentOrderMerge($fulfillmentOrderMergeInputs: [FulfillmentOrderMergeInput!]!) {
+  fulfillmentOrderMerge(fulfillmentOrderMergeInputs: $fulfillmentOrderMergeInputs) {
+    fulfillmentOrderMerges {
+      fulfillmentOrder { id }
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// Split moves the given line item quantities of a fulfillment order to a new
+// fulfillment order through the graphql endpoint
+func (s *FulfillmentOrderServiceOp) Split(ctx context.Context, fulfillmentId uint64, lineItems []FulfillmentOrderLineItemQuantity) (*FulfillmentOrderSplitResult, error) {
+	inputs := make([]fulfillmentOrderLineItemInput, 0, len(lineItems))
+	for _, li := range lineItems {
+		inputs = append(inputs, fulfillmentOrderLineItemInput{
+			Id:       NewGID(GIDResourceFulfillmentOrderLineItem, li.Id),
+			Quantity: li.Quantity,
+		})
+	}
+	vars := map[string]interface{}{
+		"fulfillmentOrderSplits": []map[string]interface{}{{
+			"fulfillmentOrderId":        NewGID(GIDResourceFulfillmentOrder, fulfillmentId),
+			"fulfillmentOrderLineItems": inputs,
+		}},
+	}
+	resp := struct {
+		FulfillmentOrderSplit struct {
+			FulfillmentOrderSplits []struct {
+				FulfillmentOrder            *fulfillmentOrderNode `json:"fulfillmentOrder"`
+				RemainingFulfillmentOrder   *fulfillmentOrderNode `json:"remainingFulfillmentOrder"`
+				ReplacementFulfillmentOrder *fulfillmentOrderNode `json:"replacementFulfillmentOrder"`
+			} `json:"fulfillmentOrderSplits"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"fulfillmentOrderSplit"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, fulfillmentOrderSplitMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.FulfillmentOrderSplit.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if len(resp.FulfillmentOrderSplit.FulfillmentOrderSplits) == 0 {
+		return nil, errors.New("fulfillment order split returned no fulfillment orders")
+	}
+
+	split := resp.FulfillmentOrderSplit.FulfillmentOrderSplits[0]
+	return &FulfillmentOrderSplitResult{
+		FulfillmentOrderId:            split.FulfillmentOrder.restId(),
+		RemainingFulfillmentOrderId:   split.RemainingFulfillmentOrder.restId(),
+		ReplacementFulfillmentOrderId: split.ReplacementFulfillmentOrder.restId(),
+	}, nil
+}
+
+// Merge merges fulfillment orders of the same order and location into one
+// through the graphql endpoint, returning the id of the merged fulfillment
+// order
+func (s *FulfillmentOrderServiceOp) Merge(ctx context.Context, fulfillmentIds []uint64) (uint64, error) {
+	intents := make([]map[string]interface{}, 0, len(fulfillmentIds))
+	for _, id := range fulfillmentIds {
+		intents = append(intents, map[string]interface{}{
+			"fulfillmentOrderId": NewGID(GIDResourceFulfillmentOrder, id),
+		})
+	}
+	vars := map[string]interface{}{
+		"fulfillmentOrderMergeInputs": []map[string]interface{}{{"mergeIntents": intents}},
+	}
+	resp := struct {
+		FulfillmentOrderMerge struct {
+			FulfillmentOrderMerges []struct {
+				FulfillmentOrder *fulfillmentOrderNode `json:"fulfillmentOrder"`
+			} `json:"fulfillmentOrderMerges"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"fulfillmentOrderMerge"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, fulfillmentOrderMergeMutation, vars, &resp)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := userErrorsToError(resp.FulfillmentOrderMerge.UserErrors); err != nil {
+		return 0, err
+	}
+
+	merges := resp.FulfillmentOrderMerge.FulfillmentOrderMerges
+	if len(merges) == 0 || merges[0].FulfillmentOrder == nil {
+		return 0, errors.New("fulfillment order merge returned no fulfillment order")
+	}
+
+	return merges[0].FulfillmentOrder.restId(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -217,3 +218,175 @@ func TestFulfillmentOrderSetDeadline(t *testing.T) {
 		t.Errorf("FulfillmentOrder.SetDeadline returned error: %v", err)
 	}
 }
+
+func TestFulfillmentOrderLocationsForMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/fulfillment_orders/1/locations_for_move.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"locations_for_move":[
+			{"location":{"id":24826418,"name":"Apple Api Shipwire"},"message":"Current location.","movable":false},
+			{"location":{"id":1072404544,"name":"Fashion Pop"},"message":"No items are stocked at this location.","movable":true}
+		]}`))
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	locations, err := fulfillmentOrderService.LocationsForMove(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.LocationsForMove returned error: %v", err)
+	}
+
+	if len(locations) != 2 {
+		t.Fatalf("FulfillmentOrder.LocationsForMove returned %d locations, expected 2", len(locations))
+	}
+
+	if locations[0].Location.Id != 24826418 || locations[0].Movable || locations[1].Location.Name != "Fashion Pop" || !locations[1].Movable {
+		t.Errorf("FulfillmentOrder.LocationsForMove returned %+v", locations)
+	}
+}
+
+func TestFulfillmentOrderSplit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]json.RawMessage
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars = graphQLRequestBody(req)
+			return httpmock.NewStringResponse(200, `{"data":{"fulfillmentOrderSplit":{
+				"fulfillmentOrderSplits":[{
+					"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1"},
+					"remainingFulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/2"},
+					"replacementFulfillmentOrder":null
+				}],
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	result, err := fulfillmentOrderService.Split(context.Background(), 1, []FulfillmentOrderLineItemQuantity{{Id: 3, Quantity: 1}})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Split returned error: %v", err)
+	}
+
+	expectedVars := `[{"fulfillmentOrderId":"gid://shopify/FulfillmentOrder/1","fulfillmentOrderLineItems":[{"id":"gid://shopify/FulfillmentOrderLineItem/3","quantity":1}]}]`
+	if string(vars["fulfillmentOrderSplits"]) != expectedVars {
+		t.Errorf("FulfillmentOrder.Split sent %s, expected %s", vars["fulfillmentOrderSplits"], expectedVars)
+	}
+
+	expected := &FulfillmentOrderSplitResult{FulfillmentOrderId: 1, RemainingFulfillmentOrderId: 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("FulfillmentOrder.Split returned %+v, expected %+v", result, expected)
+	}
+}
+
+func TestFulfillmentOrderMerge(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]json.RawMessage
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars = graphQLRequestBody(req)
+			return httpmock.NewStringResponse(200, `{"data":{"fulfillmentOrderMerge":{
+				"fulfillmentOrderMerges":[{"fulfillmentOrder":{"id":"gid://shopify/FulfillmentOrder/1"}}],
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	id, err := fulfillmentOrderService.Merge(context.Background(), []uint64{1, 2})
+	if err != nil {
+		t.Fatalf("FulfillmentOrder.Merge returned error: %v", err)
+	}
+
+	expectedVars := `[{"mergeIntents":[{"fulfillmentOrderId":"gid://shopify/FulfillmentOrder/1"},{"fulfillmentOrderId":"gid://shopify/FulfillmentOrder/2"}]}]`
+	if string(vars["fulfillmentOrderMergeInputs"]) != expectedVars {
+		t.Errorf("FulfillmentOrder.Merge sent %s, expected %s", vars["fulfillmentOrderMergeInputs"], expectedVars)
+	}
+
+	if id != 1 {
+		t.Errorf("FulfillmentOrder.Merge returned %d, expected %d", id, 1)
+	}
+}
+
+func TestFulfillmentOrderMergeUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"fulfillmentOrderMerge":{
+			"fulfillmentOrderMerges":null,
+			"userErrors":[{"field":["fulfillmentOrderMergeInputs","0"],"message":"Fulfillment orders must have the same location"}]
+		}}}`),
+	)
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	_, err := fulfillmentOrderService.Merge(context.Background(), []uint64{1, 2})
+
+	expected := "fulfillmentOrderMergeInputs.0: Fulfillment orders must have the same location"
+	if err == nil || err.Error() != expected {
+		t.Errorf("FulfillmentOrder.Merge returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestFulfillmentOrderSplitEmptyPayload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"fulfillmentOrderSplit":{
+			"fulfillmentOrderSplits":[],
+			"userErrors":[]
+		}}}`),
+	)
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	result, err := fulfillmentOrderService.Split(context.Background(), 1, []FulfillmentOrderLineItemQuantity{{Id: 2, Quantity: 1}})
+
+	expected := "fulfillment order split returned no fulfillment orders"
+	if err == nil || err.Error() != expected {
+		t.Errorf("FulfillmentOrder.Split returned error %v, expected %s", err, expected)
+	}
+
+	if result != nil {
+		t.Errorf("FulfillmentOrder.Split returned %+v, expected nil", result)
+	}
+}
+
+func TestFulfillmentOrderMergeEmptyPayload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"fulfillmentOrderMerge":{
+			"fulfillmentOrderMerges":[],
+			"userErrors":[]
+		}}}`),
+	)
+
+	fulfillmentOrderService := &FulfillmentOrderServiceOp{client: client}
+
+	_, err := fulfillmentOrderService.Merge(context.Background(), []uint64{1, 2})
+
+	expected := "fulfillment order merge returned no fulfillment order"
+	if err == nil || err.Error() != expected {
+		t.Errorf("FulfillmentOrder.Merge returned error %v, expected %s", err, expected)
+	}
+}
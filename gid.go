@@ -0,0 +1,215 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const gidScheme = "gid"
+const gidNamespace = "shopify"
+
+// GIDResourceType is the resource type part of a Shopify global id,
+// e.g. "Product" in gid://shopify/Product/123
+type GIDResourceType string
+
+const (
+	GIDResourceProduct                  GIDResourceType = "Product"
+	GIDResourceProductVariant           GIDResourceType = "ProductVariant"
+	GIDResourceOrder                    GIDResourceType = "Order"
+	GIDResourceInventoryLevel           GIDResourceType = "InventoryLevel"
+	GIDResourceMediaImage               GIDResourceType = "MediaImage"
+	GIDResourceBulkOperation            GIDResourceType = "BulkOperation"
+	GIDResourceLocation                 GIDResourceType = "Location"
+	GIDResourceCalculatedOrder          GIDResourceType = "CalculatedOrder"
+	GIDResourceCalculatedLineItem       GIDResourceType = "CalculatedLineItem"
+	GIDResourceCustomer                 GIDResourceType = "Customer"
+	GIDResourceJob                      GIDResourceType = "Job"
+	GIDResourceFulfillmentOrder         GIDResourceType = "FulfillmentOrder"
+	GIDResourceFulfillmentOrderLineItem GIDResourceType = "FulfillmentOrderLineItem"
+	GIDResourceCollection               GIDResourceType = "Collection"
+	GIDResourcePublication              GIDResourceType = "Publication"
+	GIDResourceDeliveryProfile          GIDResourceType = "DeliveryProfile"
+	GIDResourceDeliveryLocationGroup    GIDResourceType = "DeliveryLocationGroup"
+	GIDResourceDeliveryZone             GIDResourceType = "DeliveryZone"
+	GIDResourceDeliveryMethodDefinition GIDResourceType = "DeliveryMethodDefinition"
+	GIDResourceDeliveryCondition        GIDResourceType = "DeliveryCondition"
+	GIDResourceMetaobject               GIDResourceType = "Metaobject"
+	GIDResourceMetaobjectDefinition     GIDResourceType = "MetaobjectDefinition"
+	GIDResourcePage                     GIDResourceType = "OnlineStorePage"
+	GIDResourceMetafieldDefinition      GIDResourceType = "MetafieldDefinition"
+	GIDResourceProductImage             GIDResourceType = "ProductImage"
+	GIDResourceDraftOrder               GIDResourceType = "DraftOrder"
+	GIDResourceShop                     GIDResourceType = "Shop"
+	GIDResourceArticle                  GIDResourceType = "OnlineStoreArticle"
+	GIDResourceBlog                     GIDResourceType = "OnlineStoreBlog"
+	GIDResourceMarket                   GIDResourceType = "Market"
+	GIDResourceMarketRegionCountry      GIDResourceType = "MarketRegionCountry"
+	GIDResourcePriceList                GIDResourceType = "PriceList"
+)
+
+// GID represents a Shopify global id as used by the GraphQL Admin API,
+// e.g. gid://shopify/Product/123. REST resources expose the same value
+// through their AdminGraphqlApiId field.
+// See: https://shopify.dev/docs/api/usage/gids
+type GID struct {
+	Resource GIDResourceType
+	Id       uint64
+
+	// Key holds the id when it is not numeric, e.g. the uuid of a line item
+	// added during an order edit
+	Key string
+
+	// Params holds any query parameters that are part of the id, e.g.
+	// gid://shopify/InventoryLevel/1?inventory_item_id=2
+	Params url.Values
+}
+
+// NewGID returns the global id of the given resource type and numeric REST id
+func NewGID(resource GIDResourceType, id uint64) GID {
+	return GID{Resource: resource, Id: id}
+}
+
+// ParseGID parses a global id of the form gid://shopify/{resource}/{id}
+func ParseGID(s string) (GID, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return GID{}, fmt.Errorf("invalid gid %q: %w", s, err)
+	}
+
+	if u.Scheme != gidScheme || u.Host != gidNamespace {
+		return GID{}, fmt.Errorf("invalid gid %q: expected gid://%s/ prefix", s, gidNamespace)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return GID{}, fmt.Errorf("invalid gid %q: expected gid://%s/{resource}/{id}", s, gidNamespace)
+	}
+
+	gid := GID{Resource: GIDResourceType(parts[0])}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		gid.Key = parts[1]
+	} else {
+		gid.Id = id
+	}
+
+	if u.RawQuery != "" {
+		gid.Params = u.Query()
+	}
+
+	return gid, nil
+}
+
+// ParseGIDOf parses a global id and validates that it refers to the given
+// resource type
+func ParseGIDOf(resource GIDResourceType, s string) (GID, error) {
+	gid, err := ParseGID(s)
+	if err != nil {
+		return GID{}, err
+	}
+
+	if err := gid.Validate(resource); err != nil {
+		return GID{}, err
+	}
+
+	return gid, nil
+}
+
+// MustParseGID parses a global id and panics if an error occurs
+func MustParseGID(s string) GID {
+	gid, err := ParseGID(s)
+	if err != nil {
+		panic(err)
+	}
+	return gid
+}
+
+// Validate returns an error if the global id does not refer to the given
+// resource type
+func (g GID) Validate(resource GIDResourceType) error {
+	if g.Resource != resource {
+		return fmt.Errorf("gid %q is a %s, expected %s", g.String(), g.Resource, resource)
+	}
+	return nil
+}
+
+// IsZero reports whether the global id is unset
+func (g GID) IsZero() bool {
+	return g.Resource == "" && g.Id == 0 && g.Key == ""
+}
+
+// String formats the global id, e.g. gid://shopify/Product/123
+func (g GID) String() string {
+	if g.IsZero() {
+		return ""
+	}
+
+	id := g.Key
+	if id == "" {
+		id = strconv.FormatUint(g.Id, 10)
+	}
+
+	u := url.URL{
+		Scheme:   gidScheme,
+		Host:     gidNamespace,
+		Path:     fmt.Sprintf("/%s/%s", g.Resource, id),
+		RawQuery: g.Params.Encode(),
+	}
+
+	return u.String()
+}
+
+// MarshalJSON encodes the global id as a string, an unset id is encoded as null
+func (g GID) MarshalJSON() ([]byte, error) {
+	if g.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(g.String())
+}
+
+// UnmarshalJSON decodes a global id from a string, null or an empty string
+// decodes to the zero value
+func (g *GID) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == nil || *s == "" {
+		*g = GID{}
+		return nil
+	}
+
+	gid, err := ParseGID(*s)
+	if err != nil {
+		return err
+	}
+
+	*g = gid
+	return nil
+}
+
+// GIDToId returns the numeric REST id of a global id string after validating
+// that it refers to the given resource type
+func GIDToId(resource GIDResourceType, s string) (uint64, error) {
+	gid, err := ParseGIDOf(resource, s)
+	if err != nil {
+		return 0, err
+	}
+
+	if gid.Key != "" {
+		return 0, fmt.Errorf("invalid gid %q: id is not numeric", s)
+	}
+
+	return gid.Id, nil
+}
+
+// IdToGID returns the global id string of the given resource type and numeric
+// REST id
+func IdToGID(resource GIDResourceType, id uint64) string {
+	return NewGID(resource, id).String()
+}
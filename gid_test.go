@@ -0,0 +1,126 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseGID(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected GID
+	}{
+		{"gid://shopify/Product/123", GID{Resource: GIDResourceProduct, Id: 123}},
+		{"gid://shopify/ProductVariant/18446744073709551615", GID{Resource: GIDResourceProductVariant, Id: 18446744073709551615}},
+		{
+			"gid://shopify/InventoryLevel/1?inventory_item_id=2",
+			GID{Resource: GIDResourceInventoryLevel, Id: 1, Params: url.Values{"inventory_item_id": {"2"}}},
+		},
+		{
+			"gid://shopify/CalculatedLineItem/e5ab1dd6-6c2d-4c80-bc5a-3efdc5ad1e8a",
+			GID{Resource: GIDResourceCalculatedLineItem, Key: "e5ab1dd6-6c2d-4c80-bc5a-3efdc5ad1e8a"},
+		},
+	}
+
+	for _, c := range cases {
+		actual, err := ParseGID(c.in)
+		if err != nil {
+			t.Errorf("ParseGID(%s) returned error: %v", c.in, err)
+		}
+		if !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("ParseGID(%s): expected %+v, actual %+v", c.in, c.expected, actual)
+		}
+		if actual.String() != c.in {
+			t.Errorf("GID.String(): expected %s, actual %s", c.in, actual.String())
+		}
+	}
+}
+
+func TestParseGIDError(t *testing.T) {
+	cases := []string{
+		"",
+		"123",
+		"https://shopify/Product/123",
+		"gid://other/Product/123",
+		"gid://shopify/Product",
+		"gid://shopify/Product/",
+		"gid://shopify/Product/123/456",
+	}
+
+	for _, c := range cases {
+		_, err := ParseGID(c)
+		if err == nil {
+			t.Errorf("ParseGID(%s) expected error", c)
+		}
+	}
+}
+
+func TestParseGIDOf(t *testing.T) {
+	_, err := ParseGIDOf(GIDResourceProduct, "gid://shopify/Product/1")
+	if err != nil {
+		t.Errorf("ParseGIDOf returned error: %v", err)
+	}
+
+	_, err = ParseGIDOf(GIDResourceOrder, "gid://shopify/Product/1")
+	expected := `gid "gid://shopify/Product/1" is a Product, expected Order`
+	if err == nil || err.Error() != expected {
+		t.Errorf("ParseGIDOf returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestGIDToId(t *testing.T) {
+	id, err := GIDToId(GIDResourceProduct, "gid://shopify/Product/632910392")
+	if err != nil {
+		t.Errorf("GIDToId returned error: %v", err)
+	}
+	if id != 632910392 {
+		t.Errorf("GIDToId returned %d, expected %d", id, 632910392)
+	}
+
+	_, err = GIDToId(GIDResourceProduct, "gid://shopify/Product/abc")
+	if err == nil {
+		t.Error("GIDToId expected error for non numeric id")
+	}
+
+	gid := IdToGID(GIDResourceProduct, id)
+	if gid != "gid://shopify/Product/632910392" {
+		t.Errorf("IdToGID returned %s, expected %s", gid, "gid://shopify/Product/632910392")
+	}
+}
+
+func TestGIDJSON(t *testing.T) {
+	type resource struct {
+		Id       GID  `json:"id"`
+		ParentId GID  `json:"parentId"`
+		Other    *GID `json:"other"`
+	}
+
+	in := `{"id":"gid://shopify/Product/1","parentId":null,"other":""}`
+	r := resource{}
+	err := json.Unmarshal([]byte(in), &r)
+	if err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	expected := resource{Id: NewGID(GIDResourceProduct, 1), Other: &GID{}}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("json.Unmarshal returned %+v, expected %+v", r, expected)
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	expectedOut := `{"id":"gid://shopify/Product/1","parentId":null,"other":null}`
+	if string(out) != expectedOut {
+		t.Errorf("json.Marshal returned %s, expected %s", out, expectedOut)
+	}
+
+	err = json.Unmarshal([]byte(`{"id":"Product/1"}`), &r)
+	if err == nil {
+		t.Error("json.Unmarshal expected error for invalid gid")
+	}
+}
@@ -18,6 +18,10 @@ type GiftCardService interface {
 	Create(context.Context, GiftCard) (*GiftCard, error)
 	Update(context.Context, GiftCard) (*GiftCard, error)
 	List(context.Context) ([]GiftCard, error)
+	ListAll(context.Context, interface{}) ([]GiftCard, error)
+	ListWithPagination(context.Context, interface{}) ([]GiftCard, *Pagination, error)
+	Search(context.Context, interface{}) ([]GiftCard, error)
+	SearchWithPagination(context.Context, interface{}) ([]GiftCard, *Pagination, error)
 	Disable(context.Context, uint64) (*GiftCard, error)
 	Count(context.Context, interface{}) (int, error)
 }
@@ -48,6 +52,29 @@ type GiftCard struct {
 	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
 }
 
+type GiftCardStatus string
+
+const (
+	GiftCardStatusEnabled  GiftCardStatus = "enabled"
+	GiftCardStatusDisabled GiftCardStatus = "disabled"
+)
+
+// GiftCardListOptions represents the options available when listing gift cards
+type GiftCardListOptions struct {
+	ListOptions
+	Status GiftCardStatus `url:"status,omitempty"`
+}
+
+// GiftCardSearchOptions represents the options available when searching for
+// gift cards, e.g. Query "last_characters:0e0e" and Order "balance DESC"
+type GiftCardSearchOptions struct {
+	PageInfo string `url:"page_info,omitempty"`
+	Limit    int    `url:"limit,omitempty"`
+	Fields   string `url:"fields,omitempty"`
+	Order    string `url:"order,omitempty"`
+	Query    string `url:"query,omitempty"`
+}
+
 type CustomerId struct {
 	CustomerId uint64 `json:"customer_id,omitempty"`
 }
@@ -78,6 +105,68 @@ func (s *GiftCardServiceOp) List(ctx context.Context) ([]GiftCard, error) {
 	return resource.GiftCards, err
 }
 
+// ListAll Lists all gift cards matching the options, e.g.
+// GiftCardListOptions, iterating over pages
+func (s *GiftCardServiceOp) ListAll(ctx context.Context, options interface{}) ([]GiftCard, error) {
+	collector := []GiftCard{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+// ListWithPagination lists gift cards matching the options, e.g.
+// GiftCardListOptions, and returns pagination to retrieve next/previous
+// results
+func (s *GiftCardServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]GiftCard, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", giftCardsBasePath)
+	resource := new(GiftCardsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.GiftCards, pagination, nil
+}
+
+// Search retrieves the gift cards matching a query
+func (s *GiftCardServiceOp) Search(ctx context.Context, options interface{}) ([]GiftCard, error) {
+	giftCards, _, err := s.SearchWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return giftCards, nil
+}
+
+// SearchWithPagination retrieves the gift cards matching a query and returns
+// pagination to retrieve next/previous results
+func (s *GiftCardServiceOp) SearchWithPagination(ctx context.Context, options interface{}) ([]GiftCard, *Pagination, error) {
+	path := fmt.Sprintf("%s/search.json", giftCardsBasePath)
+	resource := new(GiftCardsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.GiftCards, pagination, nil
+}
+
 // Create creates a gift card
 func (s *GiftCardServiceOp) Create(ctx context.Context, pr GiftCard) (*GiftCard, error) {
 	path := fmt.Sprintf("%s.json", giftCardsBasePath)
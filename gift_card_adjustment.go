@@ -0,0 +1,77 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// GiftCardAdjustmentService is an interface for interfacing with the gift card
+// adjustment endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/gift-card-adjustment
+type GiftCardAdjustmentService interface {
+	List(context.Context, uint64, interface{}) ([]GiftCardAdjustment, error)
+	Get(context.Context, uint64, uint64) (*GiftCardAdjustment, error)
+	Create(context.Context, uint64, GiftCardAdjustment) (*GiftCardAdjustment, error)
+}
+
+// GiftCardAdjustmentServiceOp handles communication with the gift card
+// adjustment related methods of the Shopify API.
+type GiftCardAdjustmentServiceOp struct {
+	client *Client
+}
+
+// GiftCardAdjustment represents a credit (positive amount) or debit
+// (negative amount) of a gift card balance
+type GiftCardAdjustment struct {
+	Id                   uint64           `json:"id,omitempty"`
+	GiftCardId           uint64           `json:"gift_card_id,omitempty"`
+	ApiClientId          uint64           `json:"api_client_id,omitempty"`
+	UserId               uint64           `json:"user_id,omitempty"`
+	OrderTransactionId   uint64           `json:"order_transaction_id,omitempty"`
+	Number               int              `json:"number,omitempty"`
+	Amount               *decimal.Decimal `json:"amount,omitempty"`
+	Note                 string           `json:"note,omitempty"`
+	RemoteTransactionRef string           `json:"remote_transaction_ref,omitempty"`
+	RemoteTransactionUrl string           `json:"remote_transaction_url,omitempty"`
+	ProcessedAt          *time.Time       `json:"processed_at,omitempty"`
+	CreatedAt            *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt            *time.Time       `json:"updated_at,omitempty"`
+}
+
+// GiftCardAdjustmentResource represents the result from the gift_cards/X/adjustments/Y.json endpoint
+type GiftCardAdjustmentResource struct {
+	Adjustment *GiftCardAdjustment `json:"adjustment"`
+}
+
+// GiftCardAdjustmentsResource represents the result from the gift_cards/X/adjustments.json endpoint
+type GiftCardAdjustmentsResource struct {
+	Adjustments []GiftCardAdjustment `json:"adjustments"`
+}
+
+// List retrieves the adjustments of a gift card
+func (s *GiftCardAdjustmentServiceOp) List(ctx context.Context, giftCardId uint64, options interface{}) ([]GiftCardAdjustment, error) {
+	path := fmt.Sprintf("%s/%d/adjustments.json", giftCardsBasePath, giftCardId)
+	resource := new(GiftCardAdjustmentsResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Adjustments, err
+}
+
+// Get retrieves a single adjustment of a gift card
+func (s *GiftCardAdjustmentServiceOp) Get(ctx context.Context, giftCardId, adjustmentId uint64) (*GiftCardAdjustment, error) {
+	path := fmt.Sprintf("%s/%d/adjustments/%d.json", giftCardsBasePath, giftCardId, adjustmentId)
+	resource := new(GiftCardAdjustmentResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.Adjustment, err
+}
+
+// Create credits or debits a gift card
+func (s *GiftCardAdjustmentServiceOp) Create(ctx context.Context, giftCardId uint64, adjustment GiftCardAdjustment) (*GiftCardAdjustment, error) {
+	path := fmt.Sprintf("%s/%d/adjustments.json", giftCardsBasePath, giftCardId)
+	wrappedData := GiftCardAdjustmentResource{Adjustment: &adjustment}
+	resource := new(GiftCardAdjustmentResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Adjustment, err
+}
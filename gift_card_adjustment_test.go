@@ -0,0 +1,98 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func giftCardAdjustmentTests(t *testing.T, adjustment GiftCardAdjustment) {
+	expectedId := uint64(1003)
+	if adjustment.Id != expectedId {
+		t.Errorf("GiftCardAdjustment.Id returned %+v, expected %+v", adjustment.Id, expectedId)
+	}
+
+	expectedGiftCardId := uint64(1)
+	if adjustment.GiftCardId != expectedGiftCardId {
+		t.Errorf("GiftCardAdjustment.GiftCardId returned %+v, expected %+v", adjustment.GiftCardId, expectedGiftCardId)
+	}
+
+	expectedAmount := decimal.NewFromFloat(-5)
+	if adjustment.Amount == nil || !adjustment.Amount.Equal(expectedAmount) {
+		t.Errorf("GiftCardAdjustment.Amount returned %+v, expected %+v", adjustment.Amount, expectedAmount)
+	}
+
+	expectedRef := "gift_card_app_transaction_193"
+	if adjustment.RemoteTransactionRef != expectedRef {
+		t.Errorf("GiftCardAdjustment.RemoteTransactionRef returned %+v, expected %+v", adjustment.RemoteTransactionRef, expectedRef)
+	}
+}
+
+func TestGiftCardAdjustmentList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1/adjustments.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("gift_card/adjustments.json")))
+
+	adjustments, err := client.GiftCardAdjustment.List(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("GiftCardAdjustment.List returned error: %v", err)
+	}
+
+	if len(adjustments) != 2 {
+		t.Fatalf("GiftCardAdjustment.List returned %d adjustments, expected 2", len(adjustments))
+	}
+
+	giftCardAdjustmentTests(t, adjustments[1])
+}
+
+func TestGiftCardAdjustmentGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1/adjustments/1003.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("gift_card/adjustment.json")))
+
+	adjustment, err := client.GiftCardAdjustment.Get(context.Background(), 1, 1003)
+	if err != nil {
+		t.Fatalf("GiftCardAdjustment.Get returned error: %v", err)
+	}
+
+	giftCardAdjustmentTests(t, *adjustment)
+}
+
+func TestGiftCardAdjustmentCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/1/adjustments.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewBytesResponse(201, loadFixture("gift_card/adjustment.json")), nil
+		})
+
+	amount := decimal.NewFromFloat(-5)
+	adjustment, err := client.GiftCardAdjustment.Create(context.Background(), 1, GiftCardAdjustment{
+		Amount:               &amount,
+		Note:                 "Customer returned gift card",
+		RemoteTransactionRef: "gift_card_app_transaction_193",
+	})
+	if err != nil {
+		t.Fatalf("GiftCardAdjustment.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"adjustment":{"amount":"-5","note":"Customer returned gift card","remote_transaction_ref":"gift_card_app_transaction_193"}}`
+	if body != expectedBody {
+		t.Errorf("GiftCardAdjustment.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	giftCardAdjustmentTests(t, *adjustment)
+}
@@ -3,6 +3,9 @@ package goshopify
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -56,6 +59,65 @@ func TestGiftCardList(t *testing.T) {
 	}
 }
 
+func TestGiftCardListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards.json", client.pathPrefix)
+
+	var query url.Values
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		resp := httpmock.NewBytesResponse(200, loadFixture("gift_card/list.json"))
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=1>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	giftCards, pagination, err := client.GiftCard.ListWithPagination(context.Background(), GiftCardListOptions{
+		ListOptions: ListOptions{Limit: 1},
+		Status:      GiftCardStatusEnabled,
+	})
+	if err != nil {
+		t.Errorf("GiftCard.ListWithPagination returned error: %v", err)
+	}
+
+	expectedQuery := url.Values{"limit": {"1"}, "status": {"enabled"}}
+	if !reflect.DeepEqual(query, expectedQuery) {
+		t.Errorf("GiftCard.ListWithPagination sent %v, expected %v", query, expectedQuery)
+	}
+
+	if len(giftCards) != 1 || giftCards[0].Id != 1 {
+		t.Errorf("GiftCard.ListWithPagination returned %+v", giftCards)
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pg2", Limit: 1}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("GiftCard.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestGiftCardSearch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"query": "last_characters:0e0e", "order": "balance DESC"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/gift_cards/search.json", client.pathPrefix),
+		params,
+		httpmock.NewBytesResponder(200, loadFixture("gift_card/list.json")),
+	)
+
+	giftCards, err := client.GiftCard.Search(context.Background(), GiftCardSearchOptions{Query: "last_characters:0e0e", Order: "balance DESC"})
+	if err != nil {
+		t.Errorf("GiftCard.Search returned error: %v", err)
+	}
+
+	if len(giftCards) != 1 || giftCards[0].LastCharacters != "0e0e" {
+		t.Errorf("GiftCard.Search returned %+v", giftCards)
+	}
+}
+
 func TestGiftCardCreate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -81,6 +81,9 @@ type Client struct {
 	retries  int
 	attempts int
 
+	// called after every graphql request, see WithGraphQLHook
+	graphQLHook GraphQLHook
+
 	RateLimits RateLimitInfo
 
 	// Services used for communicating with the API
@@ -132,6 +135,31 @@ type Client struct {
 	OrderRisk                  OrderRiskService
 	ApiPermissions             ApiPermissionsService
 	Article                    ArticlesService
+	File                       FileService
+	BulkOperation              BulkOperationService
+	Refund                     RefundService
+	OrderEdit                  OrderEditService
+	Event                      EventService
+	Country                    CountryService
+	Province                   ProvinceService
+	Dispute                    DisputeService
+	Balance                    BalanceService
+	TenderTransaction          TenderTransactionService
+	CustomerSavedSearch        CustomerSavedSearchService
+	GiftCardAdjustment         GiftCardAdjustmentService
+	CancellationRequest        CancellationRequestService
+	MarketingEvent             MarketingEventService
+	Comment                    CommentService
+	CollectionListing          CollectionListingService
+	Publication                PublicationService
+	ResourceFeedback           ResourceFeedbackService
+	DeliveryProfile            DeliveryProfileService
+	Metaobject                 MetaobjectService
+	MetafieldDefinition        MetafieldDefinitionService
+	MetafieldsSet              MetafieldsSetService
+	Translation                TranslationService
+	Market                     MarketService
+	PriceList                  PriceListService
 }
 
 // A general response error that follows a similar layout to Shopify's response
@@ -338,6 +366,31 @@ func NewClient(app App, shopName, token string, opts ...Option) (*Client, error)
 	c.OrderRisk = &OrderRiskServiceOp{client: c}
 	c.ApiPermissions = &ApiPermissionsServiceOp{client: c}
 	c.Article = &ArticlesServiceOp{client: c}
+	c.File = &FileServiceOp{client: c}
+	c.BulkOperation = &BulkOperationServiceOp{client: c}
+	c.Refund = &RefundServiceOp{client: c}
+	c.OrderEdit = &OrderEditServiceOp{client: c}
+	c.Event = &EventServiceOp{client: c}
+	c.Country = &CountryServiceOp{client: c}
+	c.Province = &ProvinceServiceOp{client: c}
+	c.Dispute = &DisputeServiceOp{client: c}
+	c.Balance = &BalanceServiceOp{client: c}
+	c.TenderTransaction = &TenderTransactionServiceOp{client: c}
+	c.CustomerSavedSearch = &CustomerSavedSearchServiceOp{client: c}
+	c.GiftCardAdjustment = &GiftCardAdjustmentServiceOp{client: c}
+	c.CancellationRequest = &CancellationRequestServiceOp{client: c}
+	c.MarketingEvent = &MarketingEventServiceOp{client: c}
+	c.Comment = &CommentServiceOp{client: c}
+	c.CollectionListing = &CollectionListingServiceOp{client: c}
+	c.Publication = &PublicationServiceOp{client: c}
+	c.ResourceFeedback = &ResourceFeedbackServiceOp{client: c}
+	c.DeliveryProfile = &DeliveryProfileServiceOp{client: c}
+	c.Metaobject = &MetaobjectServiceOp{client: c}
+	c.MetafieldDefinition = &MetafieldDefinitionServiceOp{client: c}
+	c.MetafieldsSet = &MetafieldsSetServiceOp{client: c}
+	c.Translation = &TranslationServiceOp{client: c}
+	c.Market = &MarketServiceOp{client: c}
+	c.PriceList = &PriceListServiceOp{client: c}
 
 	// apply any options
 	for _, opt := range opts {
@@ -2,8 +2,16 @@ package goshopify
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // GraphQLService is an interface to interact with the graphql endpoint
@@ -13,6 +21,12 @@ type GraphQLService interface {
 	Query(context.Context, string, interface{}, interface{}) error
 }
 
+// GraphQLCostDebugService is implemented by the graphql services that can
+// return the cost breakdown of a query per field, e.g. GraphQLServiceOp
+type GraphQLCostDebugService interface {
+	QueryCostDebug(context.Context, string, interface{}, interface{}) (*GraphQLCost, error)
+}
+
 // GraphQLServiceOp handles communication with the graphql endpoint of
 // the Shopify API.
 type GraphQLServiceOp struct {
@@ -34,6 +48,18 @@ type GraphQLCost struct {
 	RequestedQueryCost int                   `json:"requestedQueryCost"`
 	ActualQueryCost    *int                  `json:"actualQueryCost"`
 	ThrottleStatus     GraphQLThrottleStatus `json:"throttleStatus"`
+
+	// Fields is the cost breakdown per field, only returned by QueryCostDebug
+	Fields []GraphQLFieldCost `json:"fields,omitempty"`
+}
+
+// GraphQLFieldCost represents the cost of a single field of a graphql query
+// as returned when the Shopify-GraphQL-Cost-Debug header is set
+type GraphQLFieldCost struct {
+	Path                  []string `json:"path"`
+	DefinedCost           int      `json:"definedCost"`
+	RequestedTotalCost    int      `json:"requestedTotalCost"`
+	RequestedChildrenCost int      `json:"requestedChildrenCost"`
 }
 
 // GraphQLThrottleStatus represents the status of the shop's rate limit points
@@ -56,16 +82,136 @@ type graphQLErrorExtensions struct {
 
 const (
 	graphQLErrorCodeThrottled = "THROTTLED"
+
+	graphQLCostDebugHeader = "Shopify-GraphQL-Cost-Debug"
 )
 
+// graphQLOperationRegex matches the operation type and name at the start
+// of a graphql document, e.g. "mutation productCreate("
+var graphQLOperationRegex = regexp.MustCompile(`^\s*(?:#[^\n]*\n\s*)*(query|mutation|subscription)\s+([_A-Za-z][_0-9A-Za-z]*)`)
+
 type graphQLErrorLocation struct {
 	Line   int `json:"line"`
 	Column int `json:"column"`
 }
 
+// GraphQLUserError represents an error in the input of a graphql mutation
+// See https://shopify.dev/docs/api/admin-graphql/latest/objects/UserError
+type GraphQLUserError struct {
+	Field   []string `json:"field,omitempty"`
+	Message string   `json:"message,omitempty"`
+	Code    string   `json:"code,omitempty"`
+}
+
+func (e GraphQLUserError) Error() string {
+	if len(e.Field) == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(e.Field, "."), e.Message)
+}
+
+// MoneyV2 represents an amount in a currency as used by the Admin and
+// Storefront graphql APIs
+type MoneyV2 struct {
+	Amount       *decimal.Decimal `json:"amount,omitempty"`
+	CurrencyCode string           `json:"currencyCode,omitempty"`
+}
+
+// MoneyBag represents an amount in both the shop and presentment currencies
+type MoneyBag struct {
+	ShopMoney        MoneyV2 `json:"shopMoney"`
+	PresentmentMoney MoneyV2 `json:"presentmentMoney"`
+}
+
+// GraphQLPageInfo represents the pagination info of a connection of the
+// Admin and Storefront graphql APIs
+type GraphQLPageInfo struct {
+	HasNextPage     bool   `json:"hasNextPage"`
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+	StartCursor     string `json:"startCursor,omitempty"`
+	EndCursor       string `json:"endCursor,omitempty"`
+}
+
+// userErrorsToError converts the user errors of a mutation payload into a
+// ResponseError, returning nil if there are none
+func userErrorsToError(userErrors []GraphQLUserError) error {
+	if len(userErrors) == 0 {
+		return nil
+	}
+
+	responseError := ResponseError{Status: 200}
+	for _, userErr := range userErrors {
+		responseError.Errors = append(responseError.Errors, userErr.Error())
+	}
+
+	return responseError
+}
+
+// batchUserErrorIndex returns the index of the input a user error of a
+// batched mutation refers to, along with the rest of its field. The field of
+// such errors starts with the argument and the index of the input in the
+// batch, e.g. metafields.3.value, the offset of the batch is added to the index.
+// The index is -1 if the error is not related to an input.
+func batchUserErrorIndex(userErr GraphQLUserError, argument string, offset int) (int, []string) {
+	if len(userErr.Field) >= 2 && userErr.Field[0] == argument {
+		if i, err := strconv.Atoi(userErr.Field[1]); err == nil {
+			return offset + i, userErr.Field[2:]
+		}
+	}
+	return -1, userErr.Field
+}
+
+// GraphQLRequestInfo describes a graphql request sent to the Shopify API,
+// as passed to the GraphQLHook. Throttled requests that are retried call the
+// hook once per attempt.
+type GraphQLRequestInfo struct {
+	// OperationName is the name of the operation, or "anonymous"
+	OperationName string
+
+	// Cost is the cost reported by Shopify, nil if none was reported
+	Cost *GraphQLCost
+
+	// Duration is the time taken by the request
+	Duration time.Duration
+
+	// Err is the error of the request, including graphql errors
+	Err error
+}
+
+// GraphQLHook is called after every graphql request, see WithGraphQLHook
+type GraphQLHook func(context.Context, GraphQLRequestInfo)
+
 // Query creates a graphql query against the Shopify API
 // the "data" portion of the response is unmarshalled into resp
 func (s *GraphQLServiceOp) Query(ctx context.Context, q string, vars, resp interface{}) error {
+	_, err := s.query(ctx, q, vars, resp, nil)
+	return err
+}
+
+// QueryCostDebug creates a graphql query against the Shopify API with the
+// Shopify-GraphQL-Cost-Debug header set, returning the cost of the query
+// broken down by field. The cost is also returned when the query fails,
+// e.g. with MAX_COST_EXCEEDED, if Shopify reported it.
+func (s *GraphQLServiceOp) QueryCostDebug(ctx context.Context, q string, vars, resp interface{}) (*GraphQLCost, error) {
+	header := http.Header{}
+	header.Set(graphQLCostDebugHeader, "1")
+	return s.query(ctx, q, vars, resp, header)
+}
+
+// GraphQLOperationName returns the name of the operation in a graphql
+// document, e.g. "productCreate" for "mutation productCreate(...) {...}".
+// Anonymous operations return an empty string.
+func GraphQLOperationName(q string) string {
+	match := graphQLOperationRegex.FindStringSubmatch(q)
+	if len(match) != 3 {
+		return ""
+	}
+	return match[2]
+}
+
+// query sends a graphql query with any additional headers and returns the
+// cost reported by Shopify
+func (s *GraphQLServiceOp) query(ctx context.Context, q string, vars, resp interface{}, header http.Header) (*GraphQLCost, error) {
 	data := struct {
 		Query     string      `json:"query"`
 		Variables interface{} `json:"variables"`
@@ -74,6 +220,11 @@ func (s *GraphQLServiceOp) Query(ctx context.Context, q string, vars, resp inter
 		Variables: vars,
 	}
 
+	operationName := GraphQLOperationName(q)
+	if operationName == "" {
+		operationName = "anonymous"
+	}
+
 	attempts := 0
 
 	for {
@@ -81,19 +232,50 @@ func (s *GraphQLServiceOp) Query(ctx context.Context, q string, vars, resp inter
 			Data: resp,
 		}
 
-		err := s.client.Post(ctx, "graphql.json", data, &gr)
+		req, err := s.client.NewRequest(ctx, "POST", path.Join(s.client.pathPrefix, "graphql.json"), data, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for k, values := range header {
+			req.Header[k] = values
+		}
+
+		s.client.log.Debugf("graphql operation: %s", operationName)
+		start := time.Now()
+		_, err = s.client.doGetHeaders(req, &gr)
+		duration := time.Since(start)
 
 		// internal attempts count towards outer total
 		attempts += 1
 
 		var retryAfterSecs float64
+		var cost *GraphQLCost
 
 		if gr.Extensions != nil {
-			retryAfterSecs = gr.Extensions.Cost.RetryAfterSeconds()
-			s.client.RateLimits.GraphQLCost = &gr.Extensions.Cost
+			cost = &gr.Extensions.Cost
+			retryAfterSecs = cost.RetryAfterSeconds()
+			s.client.RateLimits.GraphQLCost = cost
 			s.client.RateLimits.RetryAfterSeconds = retryAfterSecs
 		}
 
+		if s.client.graphQLHook != nil {
+			hookErr := err
+			if hookErr == nil && len(gr.Errors) > 0 {
+				responseError := ResponseError{Status: 200}
+				for _, graphQLErr := range gr.Errors {
+					responseError.Errors = append(responseError.Errors, graphQLErr.Message)
+				}
+				hookErr = responseError
+			}
+			s.client.graphQLHook(ctx, GraphQLRequestInfo{
+				OperationName: operationName,
+				Cost:          cost,
+				Duration:      duration,
+				Err:           hookErr,
+			})
+		}
+
 		if len(gr.Errors) > 0 {
 			responseError := ResponseError{Status: 200}
 			var doRetry bool
@@ -101,7 +283,7 @@ func (s *GraphQLServiceOp) Query(ctx context.Context, q string, vars, resp inter
 			for _, err := range gr.Errors {
 				if err.Extensions != nil && err.Extensions.Code == graphQLErrorCodeThrottled {
 					if attempts >= s.client.retries {
-						return RateLimitError{
+						return cost, RateLimitError{
 							RetryAfter: int(math.Ceil(retryAfterSecs)),
 							ResponseError: ResponseError{
 								Status:  200,
@@ -127,7 +309,7 @@ func (s *GraphQLServiceOp) Query(ctx context.Context, q string, vars, resp inter
 			err = responseError
 		}
 
-		return err
+		return cost, err
 	}
 }
 
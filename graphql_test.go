@@ -371,3 +371,239 @@ func TestGraphQLCostRetryAfterSeconds(t *testing.T) {
 func makeIntPointer(v int) *int {
 	return &v
 }
+
+func TestGraphQLOperationName(t *testing.T) {
+	cases := []struct {
+		in, expected string
+	}{
+		{"query {}", ""},
+		{"{ shop { name } }", ""},
+		{"query shop { shop { name } }", "shop"},
+		{"mutation productCreate($input: ProductInput!) { productCreate(input: $input) { product { id } } }", "productCreate"},
+		{"\n  # fetch products\n  query GetProducts($first: Int) { products(first: $first) { edges { node { id } } } }", "GetProducts"},
+		{"subscription onEvent { event }", "onEvent"},
+	}
+
+	for _, c := range cases {
+		actual := GraphQLOperationName(c.in)
+		if actual != c.expected {
+			t.Errorf("GraphQLOperationName(%s): expected %s, actual %s", c.in, c.expected, actual)
+		}
+	}
+}
+
+func TestBatchUserErrorIndex(t *testing.T) {
+	cases := []struct {
+		field         []string
+		index         int
+		expectedField []string
+	}{
+		{[]string{"metafields", "3", "value"}, 28, []string{"value"}},
+		{[]string{"metafields", "0"}, 25, []string{}},
+		{[]string{"ownerId"}, -1, []string{"ownerId"}},
+		{[]string{"metaobjects", "3"}, -1, []string{"metaobjects", "3"}},
+		{[]string{"metafields", "first"}, -1, []string{"metafields", "first"}},
+		{nil, -1, nil},
+	}
+
+	for _, c := range cases {
+		index, field := batchUserErrorIndex(GraphQLUserError{Field: c.field}, "metafields", 25)
+		if index != c.index || !reflect.DeepEqual(field, c.expectedField) {
+			t.Errorf("batchUserErrorIndex(%v): expected %d %v, actual %d %v", c.field, c.index, c.expectedField, index, field)
+		}
+	}
+}
+
+func TestGraphQLQueryCostDebug(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var costDebugHeader string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			costDebugHeader = req.Header.Get("Shopify-GraphQL-Cost-Debug")
+			return httpmock.NewStringResponse(200, `
+				{
+					"data":{"shop":{"name":"foo"}},
+					"extensions":{
+						"cost":{
+							"requestedQueryCost":2,
+							"actualQueryCost":2,
+							"throttleStatus":{
+								"maximumAvailable":1000.0,
+								"currentlyAvailable":998,
+								"restoreRate":50.0
+							},
+							"fields":[
+								{"path":["shop","name"],"definedCost":0,"requestedTotalCost":0,"requestedChildrenCost":null},
+								{"path":["shop"],"definedCost":1,"requestedTotalCost":1,"requestedChildrenCost":0}
+							]
+						}
+					}
+				}`), nil
+		},
+	)
+
+	resp := struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}{}
+	debugger, ok := client.GraphQL.(GraphQLCostDebugService)
+	if !ok {
+		t.Fatalf("GraphQL service %T does not implement GraphQLCostDebugService", client.GraphQL)
+	}
+
+	cost, err := debugger.QueryCostDebug(context.Background(), "query shop { shop { name } }", nil, &resp)
+	if err != nil {
+		t.Fatalf("GraphQL.QueryCostDebug returned error: %v", err)
+	}
+
+	if costDebugHeader != "1" {
+		t.Errorf("GraphQL.QueryCostDebug sent cost debug header %q, expected %q", costDebugHeader, "1")
+	}
+
+	if resp.Shop.Name != "foo" {
+		t.Errorf("GraphQL.QueryCostDebug resp.Shop.Name returned %s expected %s", resp.Shop.Name, "foo")
+	}
+
+	expectedFields := []GraphQLFieldCost{
+		{Path: []string{"shop", "name"}},
+		{Path: []string{"shop"}, DefinedCost: 1, RequestedTotalCost: 1},
+	}
+	if cost == nil || !reflect.DeepEqual(cost.Fields, expectedFields) {
+		t.Errorf("GraphQL.QueryCostDebug returned cost %+v, expected fields %+v", cost, expectedFields)
+	}
+}
+
+func TestGraphQLQueryCostDebugMaxCostExceeded(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `
+			{
+				"errors":[{"message":"Query cost is 2002, which exceeds the single query max cost limit (1000).","extensions":{"code":"MAX_COST_EXCEEDED","cost":2002,"maxCost":1000}}],
+				"extensions":{
+					"cost":{
+						"requestedQueryCost":2002,
+						"actualQueryCost":null,
+						"throttleStatus":{
+							"maximumAvailable":1000.0,
+							"currentlyAvailable":1000,
+							"restoreRate":50.0
+						},
+						"fields":[
+							{"path":["products"],"definedCost":2002,"requestedTotalCost":2002,"requestedChildrenCost":2000}
+						]
+					}
+				}
+			}`),
+	)
+
+	resp := struct{}{}
+	cost, err := client.GraphQL.(*GraphQLServiceOp).QueryCostDebug(context.Background(), "query products { products(first: 250) { edges { node { id } } } }", nil, &resp)
+	if err == nil {
+		t.Error("GraphQL.QueryCostDebug should return error!")
+	}
+
+	if cost == nil || cost.RequestedQueryCost != 2002 || len(cost.Fields) != 1 {
+		t.Errorf("GraphQL.QueryCostDebug returned cost %+v", cost)
+	}
+}
+
+func TestGraphQLHook(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var infos []GraphQLRequestInfo
+	WithGraphQLHook(func(ctx context.Context, info GraphQLRequestInfo) {
+		infos = append(infos, info)
+	})(client)
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `
+			{
+				"data":{"shop":{"name":"foo"}},
+				"extensions":{
+					"cost":{
+						"requestedQueryCost":2,
+						"actualQueryCost":1,
+						"throttleStatus":{
+							"maximumAvailable":1000.0,
+							"currentlyAvailable":999,
+							"restoreRate":50.0
+						}
+					}
+				}
+			}`),
+	)
+
+	resp := struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}{}
+	err := client.GraphQL.Query(context.Background(), "query shop { shop { name } }", nil, &resp)
+	if err != nil {
+		t.Fatalf("GraphQL.Query returned error: %v", err)
+	}
+
+	if len(infos) != 1 {
+		t.Fatalf("GraphQL.Query called hook %d times, expected 1", len(infos))
+	}
+
+	info := infos[0]
+	if info.OperationName != "shop" {
+		t.Errorf("GraphQL.Query hook got operation name %q, expected %q", info.OperationName, "shop")
+	}
+	if info.Cost == nil || info.Cost.RequestedQueryCost != 2 || info.Cost.ThrottleStatus.CurrentlyAvailable != 999 {
+		t.Errorf("GraphQL.Query hook got cost %+v, expected requested cost 2 and 999 available", info.Cost)
+	}
+	if info.Err != nil {
+		t.Errorf("GraphQL.Query hook got error %v, expected nil", info.Err)
+	}
+}
+
+func TestGraphQLHookErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var infos []GraphQLRequestInfo
+	WithGraphQLHook(func(ctx context.Context, info GraphQLRequestInfo) {
+		infos = append(infos, info)
+	})(client)
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"errors":[{"message":"Field 'foo' doesn't exist on type 'Shop'"}]}`),
+	)
+
+	resp := struct{}{}
+	err := client.GraphQL.Query(context.Background(), "{ shop { foo } }", nil, &resp)
+	if err == nil {
+		t.Fatal("GraphQL.Query expected error, got nil")
+	}
+
+	if len(infos) != 1 {
+		t.Fatalf("GraphQL.Query called hook %d times, expected 1", len(infos))
+	}
+
+	info := infos[0]
+	if info.OperationName != "anonymous" {
+		t.Errorf("GraphQL.Query hook got operation name %q, expected %q", info.OperationName, "anonymous")
+	}
+	if info.Cost != nil {
+		t.Errorf("GraphQL.Query hook got cost %+v, expected nil", info.Cost)
+	}
+	if info.Err == nil || info.Err.Error() != err.Error() {
+		t.Errorf("GraphQL.Query hook got error %v, expected %v", info.Err, err)
+	}
+}
@@ -0,0 +1,202 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+)
+
+// MarketService is an interface for reading Shopify Markets through the
+// graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/markets
+type MarketService interface {
+	List(context.Context) ([]Market, error)
+	Get(context.Context, GID) (*Market, error)
+	ListRegions(context.Context, GID) ([]MarketRegion, error)
+}
+
+// MarketServiceOp handles communication with the market related methods of
+// the Shopify API.
+type MarketServiceOp struct {
+	client *Client
+}
+
+// Market represents a group of regions sharing currency and pricing settings,
+// Regions is only set by Get
+type Market struct {
+	Id               GID                     `json:"id"`
+	Name             string                  `json:"name,omitempty"`
+	Handle           string                  `json:"handle,omitempty"`
+	Enabled          bool                    `json:"enabled"`
+	Primary          bool                    `json:"primary"`
+	Regions          []MarketRegion          `json:"regions,omitempty"`
+	CurrencySettings *MarketCurrencySettings `json:"currencySettings,omitempty"`
+	PriceList        *PriceList              `json:"priceList,omitempty"`
+}
+
+// MarketRegion represents a region of a market, Code and Currency are only
+// set for countries
+type MarketRegion struct {
+	Id       GID                    `json:"id"`
+	Name     string                 `json:"name,omitempty"`
+	Code     string                 `json:"code,omitempty"`
+	Currency *MarketCurrencySetting `json:"currency,omitempty"`
+}
+
+// MarketCurrencySettings represents the currency settings of a market
+type MarketCurrencySettings struct {
+	BaseCurrency    MarketCurrencySetting `json:"baseCurrency"`
+	LocalCurrencies bool                  `json:"localCurrencies"`
+}
+
+// MarketCurrencySetting represents a currency of a market
+type MarketCurrencySetting struct {
+	CurrencyCode string `json:"currencyCode"`
+	CurrencyName string `json:"currencyName,omitempty"`
+	Enabled      bool   `json:"enabled,omitempty"`
+}
+
+const marketFields = `
+    id
+    name
+    handle
+    enabled
+    primary
+    currencySettings {
+      baseCurrency {
+        currencyCode
+        currencyName
+        enabled
+      }
+      localCurrencies
+    }
+    priceList {
+      id
+      name
+      currency
+    }
+`
+
+const marketsQuery = `query markets($after: String) {
+  markets(first: 25, after: $after) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {` + marketFields + `}
+  }
+}`
+
+const marketQuery = `query market($id: ID!) {
+  market(id: $id) {` + marketFields + `}
+}`
+
+const marketRegionsQuery = `query marketRegions($id: ID!, $after: String) {
+  market(id: $id) {
+    regions(first: 250, after: $after) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {
+        id
+        name
+        ... on MarketRegionCountry {
+          code
+          currency {
+            currencyCode
+            currencyName
+            enabled
+          }
+        }
+      }
+    }
+  }
+}`
+
+// List lists all markets with their currencies, iterating over pages. The
+// regions are not included to keep the cost of the query down, see
+// ListRegions
+func (s *MarketServiceOp) List(ctx context.Context) ([]Market, error) {
+	collector := []Market{}
+	vars := map[string]interface{}{}
+
+	for {
+		resp := struct {
+			Markets struct {
+				PageInfo GraphQLPageInfo `json:"pageInfo"`
+				Nodes    []Market        `json:"nodes"`
+			} `json:"markets"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, marketsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.Markets.Nodes...)
+
+		if !resp.Markets.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.Markets.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Get retrieves a market by id along with all of its regions
+func (s *MarketServiceOp) Get(ctx context.Context, id GID) (*Market, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		Market *Market `json:"market"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, marketQuery, vars, &resp)
+	if err != nil || resp.Market == nil {
+		return nil, err
+	}
+
+	resp.Market.Regions, err = s.ListRegions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Market, nil
+}
+
+// ListRegions lists all regions of a market, iterating over pages
+func (s *MarketServiceOp) ListRegions(ctx context.Context, id GID) ([]MarketRegion, error) {
+	collector := []MarketRegion{}
+	vars := map[string]interface{}{"id": id}
+
+	for {
+		resp := struct {
+			Market *struct {
+				Regions struct {
+					PageInfo GraphQLPageInfo `json:"pageInfo"`
+					Nodes    []MarketRegion  `json:"nodes"`
+				} `json:"regions"`
+			} `json:"market"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, marketRegionsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		if resp.Market == nil {
+			return collector, fmt.Errorf("market %s not found", id)
+		}
+
+		collector = append(collector, resp.Market.Regions.Nodes...)
+
+		if !resp.Market.Regions.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.Market.Regions.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
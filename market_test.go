@@ -0,0 +1,174 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMarketList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	var queries []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			queries = append(queries, q)
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"markets":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{
+						"id":"gid://shopify/Market/1",
+						"name":"Canada",
+						"handle":"ca",
+						"enabled":true,
+						"primary":true,
+						"currencySettings":{
+							"baseCurrency":{"currencyCode":"CAD","currencyName":"Canadian Dollar","enabled":true},
+							"localCurrencies":false
+						},
+						"priceList":null
+					}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"markets":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{
+					"id":"gid://shopify/Market/2",
+					"name":"Europe",
+					"handle":"eu",
+					"enabled":true,
+					"primary":false,
+					"currencySettings":{"baseCurrency":{"currencyCode":"EUR"},"localCurrencies":true},
+					"priceList":{"id":"gid://shopify/PriceList/7","name":"Europe","currency":"EUR"}
+				}]
+			}}}`), nil
+		},
+	)
+
+	markets, err := client.Market.List(context.Background())
+	if err != nil {
+		t.Fatalf("Market.List returned error: %v", err)
+	}
+
+	expected := []Market{
+		{
+			Id:      NewGID(GIDResourceMarket, 1),
+			Name:    "Canada",
+			Handle:  "ca",
+			Enabled: true,
+			Primary: true,
+			CurrencySettings: &MarketCurrencySettings{
+				BaseCurrency: MarketCurrencySetting{CurrencyCode: "CAD", CurrencyName: "Canadian Dollar", Enabled: true},
+			},
+		},
+		{
+			Id:               NewGID(GIDResourceMarket, 2),
+			Name:             "Europe",
+			Handle:           "eu",
+			Enabled:          true,
+			CurrencySettings: &MarketCurrencySettings{BaseCurrency: MarketCurrencySetting{CurrencyCode: "EUR"}, LocalCurrencies: true},
+			PriceList:        &PriceList{Id: NewGID(GIDResourcePriceList, 7), Name: "Europe", Currency: "EUR"},
+		},
+	}
+	if !reflect.DeepEqual(markets, expected) {
+		t.Errorf("Market.List returned %+v, expected %+v", markets, expected)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("Market.List requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+
+	for _, q := range queries {
+		if strings.Contains(q, "regions") {
+			t.Errorf("Market.List requested regions in %s", q)
+		}
+	}
+}
+
+func TestMarketGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var ids, cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			ids = append(ids, string(vars["id"]))
+			if strings.HasPrefix(q, "query market(") {
+				return httpmock.NewStringResponse(200, `{"data":{"market":{
+					"id":"gid://shopify/Market/2",
+					"name":"Europe"
+				}}}`), nil
+			}
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"market":{"regions":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"id":"gid://shopify/MarketRegionCountry/2","name":"France","code":"FR","currency":{"currencyCode":"EUR"}}]
+				}}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"market":{"regions":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{"id":"gid://shopify/MarketRegionCountry/3","name":"Germany","code":"DE","currency":{"currencyCode":"EUR"}}]
+			}}}}`), nil
+		},
+	)
+
+	market, err := client.Market.Get(context.Background(), NewGID(GIDResourceMarket, 2))
+	if err != nil {
+		t.Fatalf("Market.Get returned error: %v", err)
+	}
+
+	expectedIds := []string{`"gid://shopify/Market/2"`, `"gid://shopify/Market/2"`, `"gid://shopify/Market/2"`}
+	if !reflect.DeepEqual(ids, expectedIds) {
+		t.Errorf("Market.Get sent ids %+v, expected %+v", ids, expectedIds)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("Market.Get requested region cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+
+	expected := &Market{
+		Id:   NewGID(GIDResourceMarket, 2),
+		Name: "Europe",
+		Regions: []MarketRegion{
+			{Id: NewGID(GIDResourceMarketRegionCountry, 2), Name: "France", Code: "FR", Currency: &MarketCurrencySetting{CurrencyCode: "EUR"}},
+			{Id: NewGID(GIDResourceMarketRegionCountry, 3), Name: "Germany", Code: "DE", Currency: &MarketCurrencySetting{CurrencyCode: "EUR"}},
+		},
+	}
+	if !reflect.DeepEqual(market, expected) {
+		t.Errorf("Market.Get returned %+v, expected %+v", market, expected)
+	}
+}
+
+func TestMarketListRegionsNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"market":null}}`),
+	)
+
+	_, err := client.Market.ListRegions(context.Background(), NewGID(GIDResourceMarket, 9))
+	expected := "market gid://shopify/Market/9 not found"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Market.ListRegions returned error %v, expected %s", err, expected)
+	}
+}
@@ -0,0 +1,219 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const marketingEventsBasePath = "marketing_events"
+
+// MarketingEventService is an interface for interfacing with the marketing
+// event endpoints of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/marketingevent
+type MarketingEventService interface {
+	List(context.Context, interface{}) ([]MarketingEvent, error)
+	ListAll(context.Context, interface{}) ([]MarketingEvent, error)
+	ListWithPagination(context.Context, interface{}) ([]MarketingEvent, *Pagination, error)
+	Count(context.Context, interface{}) (int, error)
+	Get(context.Context, uint64) (*MarketingEvent, error)
+	Create(context.Context, MarketingEvent) (*MarketingEvent, error)
+	Update(context.Context, MarketingEvent) (*MarketingEvent, error)
+	Delete(context.Context, uint64) error
+	CreateEngagements(context.Context, uint64, []MarketingEngagement) ([]MarketingEngagement, error)
+}
+
+// MarketingEventServiceOp handles communication with the marketing event
+// related methods of the Shopify API.
+type MarketingEventServiceOp struct {
+	client *Client
+}
+
+type MarketingEventType string
+
+const (
+	MarketingEventTypeAd            MarketingEventType = "ad"
+	MarketingEventTypePost          MarketingEventType = "post"
+	MarketingEventTypeMessage       MarketingEventType = "message"
+	MarketingEventTypeRetargeting   MarketingEventType = "retargeting"
+	MarketingEventTypeTransactional MarketingEventType = "transactional"
+	MarketingEventTypeAffiliate     MarketingEventType = "affiliate"
+	MarketingEventTypeLoyalty       MarketingEventType = "loyalty"
+	MarketingEventTypeNewsletter    MarketingEventType = "newsletter"
+	MarketingEventTypeAbandonedCart MarketingEventType = "abandoned_cart"
+)
+
+type MarketingChannel string
+
+const (
+	MarketingChannelSearch   MarketingChannel = "search"
+	MarketingChannelDisplay  MarketingChannel = "display"
+	MarketingChannelSocial   MarketingChannel = "social"
+	MarketingChannelEmail    MarketingChannel = "email"
+	MarketingChannelReferral MarketingChannel = "referral"
+)
+
+type MarketingBudgetType string
+
+const (
+	MarketingBudgetTypeDaily    MarketingBudgetType = "daily"
+	MarketingBudgetTypeLifetime MarketingBudgetType = "lifetime"
+)
+
+// MarketingEvent represents a Shopify marketing event, i.e. a campaign that
+// drives traffic to the shop
+type MarketingEvent struct {
+	Id                uint64              `json:"id,omitempty"`
+	RemoteId          string              `json:"remote_id,omitempty"`
+	EventType         MarketingEventType  `json:"event_type,omitempty"`
+	MarketingChannel  MarketingChannel    `json:"marketing_channel,omitempty"`
+	Paid              bool                `json:"paid,omitempty"`
+	ReferringDomain   string              `json:"referring_domain,omitempty"`
+	Budget            *decimal.Decimal    `json:"budget,omitempty"`
+	Currency          string              `json:"currency,omitempty"`
+	BudgetType        MarketingBudgetType `json:"budget_type,omitempty"`
+	UtmCampaign       string              `json:"utm_campaign,omitempty"`
+	UtmSource         string              `json:"utm_source,omitempty"`
+	UtmMedium         string              `json:"utm_medium,omitempty"`
+	Description       string              `json:"description,omitempty"`
+	ManageUrl         string              `json:"manage_url,omitempty"`
+	PreviewUrl        string              `json:"preview_url,omitempty"`
+	MarketedResources []MarketedResource  `json:"marketed_resources,omitempty"`
+	StartedAt         *time.Time          `json:"started_at,omitempty"`
+	ScheduledToEndAt  *time.Time          `json:"scheduled_to_end_at,omitempty"`
+	EndedAt           *time.Time          `json:"ended_at,omitempty"`
+	AdminGraphqlApiId string              `json:"admin_graphql_api_id,omitempty"`
+}
+
+// MarketedResource represents a resource promoted by a marketing event, Type
+// is e.g. "product", "collection" or "homepage"
+type MarketedResource struct {
+	Type   string `json:"type,omitempty"`
+	Id     uint64 `json:"id,omitempty"`
+	Handle string `json:"handle,omitempty"`
+}
+
+// MarketingEngagement represents the engagement metrics of a marketing event
+// on a single day. When IsCumulative is set the counts are totals since the
+// start of the event instead of counts for the day.
+type MarketingEngagement struct {
+	OccurredOn        *OnlyDate        `json:"occurred_on,omitempty"`
+	ImpressionsCount  *int             `json:"impressions_count,omitempty"`
+	ViewsCount        *int             `json:"views_count,omitempty"`
+	UniqueViewsCount  *int             `json:"unique_views_count,omitempty"`
+	ClicksCount       *int             `json:"clicks_count,omitempty"`
+	UniqueClicksCount *int             `json:"unique_clicks_count,omitempty"`
+	CommentsCount     *int             `json:"comments_count,omitempty"`
+	SharesCount       *int             `json:"shares_count,omitempty"`
+	FavoritesCount    *int             `json:"favorites_count,omitempty"`
+	AdSpend           *decimal.Decimal `json:"ad_spend,omitempty"`
+	Currency          string           `json:"currency,omitempty"`
+	IsCumulative      bool             `json:"is_cumulative,omitempty"`
+}
+
+// MarketingEventResource represents the result from the marketing_events/X.json endpoint
+type MarketingEventResource struct {
+	MarketingEvent *MarketingEvent `json:"marketing_event"`
+}
+
+// MarketingEventsResource represents the result from the marketing_events.json endpoint
+type MarketingEventsResource struct {
+	MarketingEvents []MarketingEvent `json:"marketing_events"`
+}
+
+// MarketingEngagementsResource represents the result from the marketing_events/X/engagements.json endpoint
+type MarketingEngagementsResource struct {
+	Engagements []MarketingEngagement `json:"engagements"`
+}
+
+// List marketing events
+func (s *MarketingEventServiceOp) List(ctx context.Context, options interface{}) ([]MarketingEvent, error) {
+	events, _, err := s.ListWithPagination(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// ListAll Lists all marketing events, iterating over pages
+func (s *MarketingEventServiceOp) ListAll(ctx context.Context, options interface{}) ([]MarketingEvent, error) {
+	collector := []MarketingEvent{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *MarketingEventServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]MarketingEvent, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", marketingEventsBasePath)
+	resource := new(MarketingEventsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.MarketingEvents, pagination, nil
+}
+
+// Count marketing events
+func (s *MarketingEventServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", marketingEventsBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual marketing event
+func (s *MarketingEventServiceOp) Get(ctx context.Context, eventId uint64) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s/%d.json", marketingEventsBasePath, eventId)
+	resource := new(MarketingEventResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.MarketingEvent, err
+}
+
+// Create a new marketing event
+func (s *MarketingEventServiceOp) Create(ctx context.Context, event MarketingEvent) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s.json", marketingEventsBasePath)
+	wrappedData := MarketingEventResource{MarketingEvent: &event}
+	resource := new(MarketingEventResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Update an existing marketing event
+func (s *MarketingEventServiceOp) Update(ctx context.Context, event MarketingEvent) (*MarketingEvent, error) {
+	path := fmt.Sprintf("%s/%d.json", marketingEventsBasePath, event.Id)
+	wrappedData := MarketingEventResource{MarketingEvent: &event}
+	resource := new(MarketingEventResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.MarketingEvent, err
+}
+
+// Delete an existing marketing event
+func (s *MarketingEventServiceOp) Delete(ctx context.Context, eventId uint64) error {
+	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", marketingEventsBasePath, eventId))
+}
+
+// CreateEngagements adds engagement metrics to a marketing event
+func (s *MarketingEventServiceOp) CreateEngagements(ctx context.Context, eventId uint64, engagements []MarketingEngagement) ([]MarketingEngagement, error) {
+	path := fmt.Sprintf("%s/%d/engagements.json", marketingEventsBasePath, eventId)
+	wrappedData := MarketingEngagementsResource{Engagements: engagements}
+	resource := new(MarketingEngagementsResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Engagements, err
+}
@@ -0,0 +1,178 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func marketingEventTests(t *testing.T, event MarketingEvent) {
+	expectedId := uint64(998730532)
+	if event.Id != expectedId {
+		t.Errorf("MarketingEvent.Id returned %+v, expected %+v", event.Id, expectedId)
+	}
+
+	if event.EventType != MarketingEventTypeAd || event.MarketingChannel != MarketingChannelSocial || event.BudgetType != MarketingBudgetTypeDaily {
+		t.Errorf("MarketingEvent returned event type %s, channel %s, budget type %s", event.EventType, event.MarketingChannel, event.BudgetType)
+	}
+
+	expectedBudget := decimal.RequireFromString("10.11")
+	if event.Budget == nil || !event.Budget.Equal(expectedBudget) {
+		t.Errorf("MarketingEvent.Budget returned %+v, expected %+v", event.Budget, expectedBudget)
+	}
+
+	expectedResources := []MarketedResource{{Type: "product", Id: 632910392}}
+	if !reflect.DeepEqual(event.MarketedResources, expectedResources) {
+		t.Errorf("MarketingEvent.MarketedResources returned %+v, expected %+v", event.MarketedResources, expectedResources)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedStartedAt := time.Date(2024, time.January, 15, 10, 56, 18, 0, location)
+	if event.StartedAt == nil || !expectedStartedAt.Equal(*event.StartedAt) {
+		t.Errorf("MarketingEvent.StartedAt returned %+v, expected %+v", event.StartedAt, expectedStartedAt)
+	}
+}
+
+func TestMarketingEventList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_events.json")))
+
+	events, err := client.MarketingEvent.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("MarketingEvent.List returned error: %v", err)
+	}
+
+	if len(events) != 2 || events[0].Id != 998730532 || events[1].EventType != MarketingEventTypeNewsletter {
+		t.Errorf("MarketingEvent.List returned %+v", events)
+	}
+}
+
+func TestMarketingEventCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 2}`))
+
+	cnt, err := client.MarketingEvent.Count(context.Background(), nil)
+	if err != nil {
+		t.Errorf("MarketingEvent.Count returned error: %v", err)
+	}
+
+	expected := 2
+	if cnt != expected {
+		t.Errorf("MarketingEvent.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestMarketingEventGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_event.json")))
+
+	event, err := client.MarketingEvent.Get(context.Background(), 998730532)
+	if err != nil {
+		t.Fatalf("MarketingEvent.Get returned error: %v", err)
+	}
+
+	marketingEventTests(t, *event)
+}
+
+func TestMarketingEventCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events.json", client.pathPrefix),
+		httpmock.NewBytesResponder(201, loadFixture("marketing_event.json")))
+
+	budget := decimal.RequireFromString("10.11")
+	event, err := client.MarketingEvent.Create(context.Background(), MarketingEvent{
+		EventType:        MarketingEventTypeAd,
+		MarketingChannel: MarketingChannelSocial,
+		Budget:           &budget,
+		BudgetType:       MarketingBudgetTypeDaily,
+		UtmCampaign:      "1234567890",
+	})
+	if err != nil {
+		t.Fatalf("MarketingEvent.Create returned error: %v", err)
+	}
+
+	marketingEventTests(t, *event)
+}
+
+func TestMarketingEventUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("marketing_event.json")))
+
+	event, err := client.MarketingEvent.Update(context.Background(), MarketingEvent{Id: 998730532, RemoteId: "12345678"})
+	if err != nil {
+		t.Fatalf("MarketingEvent.Update returned error: %v", err)
+	}
+
+	marketingEventTests(t, *event)
+}
+
+func TestMarketingEventDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("DELETE", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/998730532.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.MarketingEvent.Delete(context.Background(), 998730532)
+	if err != nil {
+		t.Errorf("MarketingEvent.Delete returned error: %v", err)
+	}
+}
+
+func TestMarketingEventCreateEngagements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/marketing_events/998730532/engagements.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(201, `{"engagements":[{"occurred_on":"2024-01-15","views_count":10,"clicks_count":3,"ad_spend":"5.5","currency":"GBP","is_cumulative":true}]}`), nil
+		})
+
+	occurredOn := OnlyDate{time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)}
+	views, clicks := 10, 3
+	adSpend := decimal.RequireFromString("5.5")
+	engagements, err := client.MarketingEvent.CreateEngagements(context.Background(), 998730532, []MarketingEngagement{{
+		OccurredOn:   &occurredOn,
+		ViewsCount:   &views,
+		ClicksCount:  &clicks,
+		AdSpend:      &adSpend,
+		Currency:     "GBP",
+		IsCumulative: true,
+	}})
+	if err != nil {
+		t.Fatalf("MarketingEvent.CreateEngagements returned error: %v", err)
+	}
+
+	expectedBody := `{"engagements":[{"occurred_on":"2024-01-15","views_count":10,"clicks_count":3,"ad_spend":"5.5","currency":"GBP","is_cumulative":true}]}`
+	if body != expectedBody {
+		t.Errorf("MarketingEvent.CreateEngagements sent %s, expected %s", body, expectedBody)
+	}
+
+	if len(engagements) != 1 || *engagements[0].ViewsCount != 10 || !engagements[0].OccurredOn.Equal(occurredOn.Time) {
+		t.Errorf("MarketingEvent.CreateEngagements returned %+v", engagements)
+	}
+}
@@ -3,6 +3,7 @@ package goshopify
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -92,8 +93,73 @@ const (
 
 	// MetafieldTypeWeight JSON, {"value:" 2.5, "unit": "kg"}.
 	MetafieldTypeWeight MetafieldType = "weight"
+
+	// MetafieldTypeLink JSON, {"text": "Learn more", "url": "https://shopify.com"}.
+	MetafieldTypeLink MetafieldType = "link"
+
+	// MetafieldTypeCollectionReference gid://shopify/Collection/1.
+	MetafieldTypeCollectionReference MetafieldType = "collection_reference"
+
+	// MetafieldTypeCustomerReference gid://shopify/Customer/1.
+	MetafieldTypeCustomerReference MetafieldType = "customer_reference"
+
+	// MetafieldTypeFileReference gid://shopify/MediaImage/1.
+	MetafieldTypeFileReference MetafieldType = "file_reference"
+
+	// MetafieldTypeMetaobjectReference gid://shopify/Metaobject/1.
+	MetafieldTypeMetaobjectReference MetafieldType = "metaobject_reference"
+
+	// MetafieldTypeMixedReference gid://shopify/Metaobject/1, of any metaobject definition.
+	MetafieldTypeMixedReference MetafieldType = "mixed_reference"
+
+	// MetafieldTypePageReference gid://shopify/OnlineStorePage/1.
+	MetafieldTypePageReference MetafieldType = "page_reference"
+
+	// MetafieldTypeProductReference gid://shopify/Product/1.
+	MetafieldTypeProductReference MetafieldType = "product_reference"
+
+	// MetafieldTypeVariantReference gid://shopify/ProductVariant/1.
+	MetafieldTypeVariantReference MetafieldType = "variant_reference"
 )
 
+// metafieldListTypePrefix is the prefix of the type of a list, e.g.
+// list.single_line_text_field
+const metafieldListTypePrefix = "list."
+
+// List returns the list type of t, e.g. list.product_reference, list types
+// are returned as is
+func (t MetafieldType) List() MetafieldType {
+	if t.IsList() {
+		return t
+	}
+	return MetafieldType(metafieldListTypePrefix + string(t))
+}
+
+// IsList reports whether t is a list type
+func (t MetafieldType) IsList() bool {
+	return strings.HasPrefix(string(t), metafieldListTypePrefix)
+}
+
+// ElementType returns the type of the elements of a list type, or t itself
+// if it is not a list type
+func (t MetafieldType) ElementType() MetafieldType {
+	return MetafieldType(strings.TrimPrefix(string(t), metafieldListTypePrefix))
+}
+
+// MetafieldDefinitionType represents the type of a metafield or metaobject
+// field definition
+type MetafieldDefinitionType struct {
+	Name     MetafieldType `json:"name"`
+	Category string        `json:"category,omitempty"`
+}
+
+// MetafieldDefinitionValidation represents a validation of a metafield or
+// metaobject field definition, e.g. {"name": "max", "value": "10"}
+type MetafieldDefinitionValidation struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // Metafield represents a Shopify metafield.
 type Metafield struct {
 	CreatedAt         *time.Time    `json:"created_at,omitempty"`
@@ -144,8 +210,13 @@ func (s *MetafieldServiceOp) Get(ctx context.Context, metafieldId uint64, option
 	return resource.Metafield, err
 }
 
-// Create a new metafield
+// Create a new metafield, the value is validated against the type before it
+// is sent
 func (s *MetafieldServiceOp) Create(ctx context.Context, metafield Metafield) (*Metafield, error) {
+	if err := ValidateMetafieldValue(metafield.Type, metafield.Value); err != nil {
+		return nil, err
+	}
+
 	prefix := MetafieldPathPrefix(s.resource, s.resourceId)
 	path := fmt.Sprintf("%s.json", prefix)
 	wrappedData := MetafieldResource{Metafield: &metafield}
@@ -154,8 +225,13 @@ func (s *MetafieldServiceOp) Create(ctx context.Context, metafield Metafield) (*
 	return resource.Metafield, err
 }
 
-// Update an existing metafield
+// Update an existing metafield, the value is validated against the type
+// before it is sent
 func (s *MetafieldServiceOp) Update(ctx context.Context, metafield Metafield) (*Metafield, error) {
+	if err := ValidateMetafieldValue(metafield.Type, metafield.Value); err != nil {
+		return nil, err
+	}
+
 	prefix := MetafieldPathPrefix(s.resource, s.resourceId)
 	path := fmt.Sprintf("%s/%d.json", prefix, metafield.Id)
 	wrappedData := MetafieldResource{Metafield: &metafield}
@@ -0,0 +1,391 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+)
+
+// MetafieldDefinitionService is an interface for managing metafield
+// definitions through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/custom-data/metafields/definitions
+type MetafieldDefinitionService interface {
+	List(context.Context, MetafieldOwnerType, *MetafieldDefinitionListOptions) ([]MetafieldDefinition, error)
+	Get(context.Context, GID) (*MetafieldDefinition, error)
+	Create(context.Context, MetafieldDefinitionInput) (*MetafieldDefinition, error)
+	Update(context.Context, MetafieldDefinitionUpdateInput) (*MetafieldDefinition, error)
+	Delete(context.Context, GID, bool) error
+	Pin(context.Context, GID) (*MetafieldDefinition, error)
+	Unpin(context.Context, GID) (*MetafieldDefinition, error)
+}
+
+// MetafieldDefinitionServiceOp handles communication with the metafield
+// definition related methods of the Shopify API.
+type MetafieldDefinitionServiceOp struct {
+	client *Client
+}
+
+// MetafieldOwnerType is the type of resource a metafield definition applies
+// to
+type MetafieldOwnerType string
+
+const (
+	MetafieldOwnerTypeArticle        MetafieldOwnerType = "ARTICLE"
+	MetafieldOwnerTypeBlog           MetafieldOwnerType = "BLOG"
+	MetafieldOwnerTypeCollection     MetafieldOwnerType = "COLLECTION"
+	MetafieldOwnerTypeCompany        MetafieldOwnerType = "COMPANY"
+	MetafieldOwnerTypeCustomer       MetafieldOwnerType = "CUSTOMER"
+	MetafieldOwnerTypeDraftOrder     MetafieldOwnerType = "DRAFTORDER"
+	MetafieldOwnerTypeLocation       MetafieldOwnerType = "LOCATION"
+	MetafieldOwnerTypeMarket         MetafieldOwnerType = "MARKET"
+	MetafieldOwnerTypeOrder          MetafieldOwnerType = "ORDER"
+	MetafieldOwnerTypePage           MetafieldOwnerType = "PAGE"
+	MetafieldOwnerTypeProduct        MetafieldOwnerType = "PRODUCT"
+	MetafieldOwnerTypeProductVariant MetafieldOwnerType = "PRODUCTVARIANT"
+	MetafieldOwnerTypeShop           MetafieldOwnerType = "SHOP"
+)
+
+// MetafieldDefinitionPinnedStatus filters metafield definitions by whether
+// they are pinned in the admin
+type MetafieldDefinitionPinnedStatus string
+
+const (
+	MetafieldDefinitionPinnedStatusAny      MetafieldDefinitionPinnedStatus = "ANY"
+	MetafieldDefinitionPinnedStatusPinned   MetafieldDefinitionPinnedStatus = "PINNED"
+	MetafieldDefinitionPinnedStatusUnpinned MetafieldDefinitionPinnedStatus = "UNPINNED"
+)
+
+// MetafieldDefinition represents the structure of the metafields with a
+// namespace and key on a type of resource
+type MetafieldDefinition struct {
+	Id              GID                             `json:"id"`
+	Name            string                          `json:"name,omitempty"`
+	Namespace       string                          `json:"namespace,omitempty"`
+	Key             string                          `json:"key,omitempty"`
+	Description     string                          `json:"description,omitempty"`
+	OwnerType       MetafieldOwnerType              `json:"ownerType,omitempty"`
+	Type            MetafieldDefinitionType         `json:"type"`
+	Validations     []MetafieldDefinitionValidation `json:"validations,omitempty"`
+	PinnedPosition  *int                            `json:"pinnedPosition,omitempty"`
+	MetafieldsCount int                             `json:"metafieldsCount,omitempty"`
+}
+
+// MetafieldDefinitionInput represents the input of the
+// metafieldDefinitionCreate mutation
+type MetafieldDefinitionInput struct {
+	Name        string                          `json:"name"`
+	Namespace   string                          `json:"namespace,omitempty"`
+	Key         string                          `json:"key"`
+	Description string                          `json:"description,omitempty"`
+	OwnerType   MetafieldOwnerType              `json:"ownerType"`
+	Type        MetafieldType                   `json:"type"`
+	Validations []MetafieldDefinitionValidation `json:"validations,omitempty"`
+	Pin         bool                            `json:"pin,omitempty"`
+}
+
+// MetafieldDefinitionUpdateInput represents the input of the
+// metafieldDefinitionUpdate mutation, the definition is identified by its
+// namespace, key and owner type which cannot be changed
+type MetafieldDefinitionUpdateInput struct {
+	Namespace   string                          `json:"namespace,omitempty"`
+	Key         string                          `json:"key"`
+	OwnerType   MetafieldOwnerType              `json:"ownerType"`
+	Name        string                          `json:"name,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Validations []MetafieldDefinitionValidation `json:"validations,omitempty"`
+	Pin         *bool                           `json:"pin,omitempty"`
+}
+
+// MetafieldDefinitionListOptions represents the filters of a metafield
+// definition list
+type MetafieldDefinitionListOptions struct {
+	Namespace    string                          `json:"namespace,omitempty"`
+	Key          string                          `json:"key,omitempty"`
+	PinnedStatus MetafieldDefinitionPinnedStatus `json:"pinnedStatus,omitempty"`
+	Query        string                          `json:"query,omitempty"`
+}
+
+// validate checks the required fields of the input, so incomplete
+// definitions fail before a request is made. The type itself is validated by
+// the API, which knows more types than the value codec.
+func (i MetafieldDefinitionInput) validate() error {
+	switch {
+	case i.Name == "":
+		return errors.New("metafield definition name is required")
+	case i.Key == "":
+		return errors.New("metafield definition key is required")
+	case i.OwnerType == "":
+		return errors.New("metafield definition owner type is required")
+	case i.Type == "":
+		return errors.New("metafield definition type is required")
+	}
+	return nil
+}
+
+// validate checks the fields identifying the definition to update
+func (i MetafieldDefinitionUpdateInput) validate() error {
+	switch {
+	case i.Key == "":
+		return errors.New("metafield definition key is required")
+	case i.OwnerType == "":
+		return errors.New("metafield definition owner type is required")
+	}
+	return nil
+}
+
+const metafieldDefinitionFields = `
+    id
+    name
+    namespace
+    key
+    description
+    ownerType
+    type {
+      name
+      category
+    }
+    validations {
+      name
+      value
+    }
+    pinnedPosition
+    metafieldsCount
+`
+
+const metafieldDefinitionsQuery = `query metafieldDefinitions($ownerType: MetafieldOwnerType!, $after: String, $namespace: String, $key: String, $pinnedStatus: MetafieldDefinitionPinnedStatus, $query: String) {
+  metafieldDefinitions(ownerType: $ownerType, first: 50, after: $after, namespace: $namespace, key: $key, pinnedStatus: $pinnedStatus, query: $query) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {` + metafieldDefinitionFields + `}
+  }
+}`
+
+const metafieldDefinitionQuery = `query metafieldDefinition($id: ID!) {
+  metafieldDefinition(id: $id) {` + metafieldDefinitionFields + `}
+}`
+
+const metafieldDefinitionCreateMutation = `mutation metafieldDefinitionCreate($definition: MetafieldDefinitionInput!) {
+  metafieldDefinitionCreate(definition: $definition) {
+    createdDefinition {` + metafieldDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metafieldDefinitionUpdateMutation = `mutation metafieldDefinitionUpdate($definition: MetafieldDefinitionUpdateInput!) {
+  metafieldDefinitionUpdate(definition: $definition) {
+    updatedDefinition {` + metafieldDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metafieldDefinitionDeleteMutation = `mutation metafieldDefinitionDelete($id: ID!, $deleteAllAssociatedMetafields: Boolean) {
+  metafieldDefinitionDelete(id: $id, deleteAllAssociatedMetafields: $deleteAllAssociatedMetafields) {
+    deletedDefinitionId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metafieldDefinitionPinMutation = `mutation metafieldDefinitionPin($definitionId: ID!) {
+  metafieldDefinitionPin(definitionId: $definitionId) {
+    pinnedDefinition {` + metafieldDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metafieldDefinitionUnpinMutation = `mutation metafieldDefinitionUnpin($definitionId: ID!) {
+  metafieldDefinitionUnpin(definitionId: $definitionId) {
+    unpinnedDefinition {` + metafieldDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// List lists all metafield definitions of an owner type, iterating over pages
+func (s *MetafieldDefinitionServiceOp) List(ctx context.Context, ownerType MetafieldOwnerType, options *MetafieldDefinitionListOptions) ([]MetafieldDefinition, error) {
+	collector := []MetafieldDefinition{}
+	vars := map[string]interface{}{"ownerType": ownerType}
+	if options != nil {
+		if options.Namespace != "" {
+			vars["namespace"] = options.Namespace
+		}
+		if options.Key != "" {
+			vars["key"] = options.Key
+		}
+		if options.PinnedStatus != "" {
+			vars["pinnedStatus"] = options.PinnedStatus
+		}
+		if options.Query != "" {
+			vars["query"] = options.Query
+		}
+	}
+
+	for {
+		resp := struct {
+			MetafieldDefinitions struct {
+				PageInfo GraphQLPageInfo       `json:"pageInfo"`
+				Nodes    []MetafieldDefinition `json:"nodes"`
+			} `json:"metafieldDefinitions"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, metafieldDefinitionsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.MetafieldDefinitions.Nodes...)
+
+		if !resp.MetafieldDefinitions.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.MetafieldDefinitions.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Get retrieves a metafield definition by id
+func (s *MetafieldDefinitionServiceOp) Get(ctx context.Context, id GID) (*MetafieldDefinition, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		MetafieldDefinition *MetafieldDefinition `json:"metafieldDefinition"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetafieldDefinition, nil
+}
+
+// Create creates a metafield definition
+func (s *MetafieldDefinitionServiceOp) Create(ctx context.Context, definition MetafieldDefinitionInput) (*MetafieldDefinition, error) {
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{"definition": definition}
+	resp := struct {
+		MetafieldDefinitionCreate struct {
+			CreatedDefinition *MetafieldDefinition `json:"createdDefinition"`
+			UserErrors        []GraphQLUserError   `json:"userErrors"`
+		} `json:"metafieldDefinitionCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetafieldDefinitionCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetafieldDefinitionCreate.CreatedDefinition, nil
+}
+
+// Update updates the name, description, validations or pinning of a
+// metafield definition
+func (s *MetafieldDefinitionServiceOp) Update(ctx context.Context, definition MetafieldDefinitionUpdateInput) (*MetafieldDefinition, error) {
+	if err := definition.validate(); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{"definition": definition}
+	resp := struct {
+		MetafieldDefinitionUpdate struct {
+			UpdatedDefinition *MetafieldDefinition `json:"updatedDefinition"`
+			UserErrors        []GraphQLUserError   `json:"userErrors"`
+		} `json:"metafieldDefinitionUpdate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionUpdateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetafieldDefinitionUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetafieldDefinitionUpdate.UpdatedDefinition, nil
+}
+
+// Delete deletes a metafield definition, the metafields of the definition
+// are deleted too if deleteAllAssociatedMetafields is set
+func (s *MetafieldDefinitionServiceOp) Delete(ctx context.Context, id GID, deleteAllAssociatedMetafields bool) error {
+	vars := map[string]interface{}{"id": id, "deleteAllAssociatedMetafields": deleteAllAssociatedMetafields}
+	resp := struct {
+		MetafieldDefinitionDelete struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metafieldDefinitionDelete"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionDeleteMutation, vars, &resp)
+	if err != nil {
+		return err
+	}
+
+	return userErrorsToError(resp.MetafieldDefinitionDelete.UserErrors)
+}
+
+// Pin pins a metafield definition, showing it on the resource pages in the
+// admin
+func (s *MetafieldDefinitionServiceOp) Pin(ctx context.Context, id GID) (*MetafieldDefinition, error) {
+	vars := map[string]interface{}{"definitionId": id}
+	resp := struct {
+		MetafieldDefinitionPin struct {
+			PinnedDefinition *MetafieldDefinition `json:"pinnedDefinition"`
+			UserErrors       []GraphQLUserError   `json:"userErrors"`
+		} `json:"metafieldDefinitionPin"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionPinMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetafieldDefinitionPin.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetafieldDefinitionPin.PinnedDefinition, nil
+}
+
+// Unpin unpins a metafield definition
+func (s *MetafieldDefinitionServiceOp) Unpin(ctx context.Context, id GID) (*MetafieldDefinition, error) {
+	vars := map[string]interface{}{"definitionId": id}
+	resp := struct {
+		MetafieldDefinitionUnpin struct {
+			UnpinnedDefinition *MetafieldDefinition `json:"unpinnedDefinition"`
+			UserErrors         []GraphQLUserError   `json:"userErrors"`
+		} `json:"metafieldDefinitionUnpin"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metafieldDefinitionUnpinMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetafieldDefinitionUnpin.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetafieldDefinitionUnpin.UnpinnedDefinition, nil
+}
@@ -0,0 +1,346 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMetafieldDefinitionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []map[string]string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			request := map[string]string{}
+			for k, v := range vars {
+				request[k] = string(v)
+			}
+			requests = append(requests, request)
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitions":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{
+						"id":"gid://shopify/MetafieldDefinition/1",
+						"name":"Care guide",
+						"namespace":"custom",
+						"key":"care_guide",
+						"ownerType":"PRODUCT",
+						"type":{"name":"multi_line_text_field","category":"TEXT"},
+						"validations":[],
+						"pinnedPosition":1,
+						"metafieldsCount":12
+					}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitions":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{
+					"id":"gid://shopify/MetafieldDefinition/2",
+					"name":"Fabric",
+					"namespace":"custom",
+					"key":"fabric",
+					"ownerType":"PRODUCT",
+					"type":{"name":"list.single_line_text_field","category":"TEXT"},
+					"validations":[{"name":"choices","value":"[\"cotton\",\"wool\"]"}],
+					"pinnedPosition":null,
+					"metafieldsCount":0
+				}]
+			}}}`), nil
+		},
+	)
+
+	definitions, err := client.MetafieldDefinition.List(context.Background(), MetafieldOwnerTypeProduct, &MetafieldDefinitionListOptions{
+		Namespace: "custom",
+	})
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.List returned error: %v", err)
+	}
+
+	pinnedPosition := 1
+	expected := []MetafieldDefinition{
+		{
+			Id:              NewGID(GIDResourceMetafieldDefinition, 1),
+			Name:            "Care guide",
+			Namespace:       "custom",
+			Key:             "care_guide",
+			OwnerType:       MetafieldOwnerTypeProduct,
+			Type:            MetafieldDefinitionType{Name: MetafieldTypeMultiLineTextField, Category: "TEXT"},
+			Validations:     []MetafieldDefinitionValidation{},
+			PinnedPosition:  &pinnedPosition,
+			MetafieldsCount: 12,
+		},
+		{
+			Id:          NewGID(GIDResourceMetafieldDefinition, 2),
+			Name:        "Fabric",
+			Namespace:   "custom",
+			Key:         "fabric",
+			OwnerType:   MetafieldOwnerTypeProduct,
+			Type:        MetafieldDefinitionType{Name: MetafieldTypeSingleLineTextField.List(), Category: "TEXT"},
+			Validations: []MetafieldDefinitionValidation{{Name: "choices", Value: `["cotton","wool"]`}},
+		},
+	}
+	if !reflect.DeepEqual(definitions, expected) {
+		t.Errorf("MetafieldDefinition.List returned %+v, expected %+v", definitions, expected)
+	}
+
+	expectedRequests := []map[string]string{
+		{"ownerType": `"PRODUCT"`, "namespace": `"custom"`},
+		{"ownerType": `"PRODUCT"`, "namespace": `"custom"`, "after": `"abc"`},
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("MetafieldDefinition.List sent %+v, expected %+v", requests, expectedRequests)
+	}
+}
+
+func TestMetafieldDefinitionGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"metafieldDefinition":{
+			"id":"gid://shopify/MetafieldDefinition/1",
+			"namespace":"custom",
+			"key":"care_guide",
+			"type":{"name":"multi_line_text_field","category":"TEXT"}
+		}}}`),
+	)
+
+	definition, err := client.MetafieldDefinition.Get(context.Background(), NewGID(GIDResourceMetafieldDefinition, 1))
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.Get returned error: %v", err)
+	}
+
+	if definition.Key != "care_guide" || definition.Type.Name != MetafieldTypeMultiLineTextField {
+		t.Errorf("MetafieldDefinition.Get returned %+v", definition)
+	}
+}
+
+func TestMetafieldDefinitionCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["definition"])
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionCreate":{
+				"createdDefinition":{"id":"gid://shopify/MetafieldDefinition/1","key":"rating"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	definition, err := client.MetafieldDefinition.Create(context.Background(), MetafieldDefinitionInput{
+		Name:        "Rating",
+		Namespace:   "reviews",
+		Key:         "rating",
+		OwnerType:   MetafieldOwnerTypeProduct,
+		Type:        MetafieldTypeRating,
+		Validations: []MetafieldDefinitionValidation{{Name: "scale_min", Value: "1"}, {Name: "scale_max", Value: "5"}},
+		Pin:         true,
+	})
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.Create returned error: %v", err)
+	}
+
+	expectedInput := `{"name":"Rating","namespace":"reviews","key":"rating","ownerType":"PRODUCT","type":"rating",` +
+		`"validations":[{"name":"scale_min","value":"1"},{"name":"scale_max","value":"5"}],"pin":true}`
+	if input != expectedInput {
+		t.Errorf("MetafieldDefinition.Create sent %s, expected %s", input, expectedInput)
+	}
+
+	if definition.Id.Id != 1 {
+		t.Errorf("MetafieldDefinition.Create returned %+v", definition)
+	}
+}
+
+func TestMetafieldDefinitionCreateInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		input       MetafieldDefinitionInput
+		expectedErr string
+	}{
+		{
+			MetafieldDefinitionInput{Key: "rating", OwnerType: MetafieldOwnerTypeProduct, Type: MetafieldTypeRating},
+			"metafield definition name is required",
+		},
+		{
+			MetafieldDefinitionInput{Name: "Rating", Key: "rating", OwnerType: MetafieldOwnerTypeProduct},
+			"metafield definition type is required",
+		},
+	}
+
+	for _, c := range cases {
+		_, err := client.MetafieldDefinition.Create(context.Background(), c.input)
+		if err == nil || err.Error() != c.expectedErr {
+			t.Errorf("MetafieldDefinition.Create returned error %v, expected %s", err, c.expectedErr)
+		}
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("MetafieldDefinition.Create made %d requests, expected none", calls)
+	}
+}
+
+func TestMetafieldDefinitionCreateTypeUnknownToCodec(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var types []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			var definition MetafieldDefinitionInput
+			if err := json.Unmarshal(vars["definition"], &definition); err != nil {
+				return nil, err
+			}
+			types = append(types, string(definition.Type))
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionCreate":{
+				"createdDefinition":{"id":"gid://shopify/MetafieldDefinition/1"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	for _, metafieldType := range []MetafieldType{"id", "list.company_reference"} {
+		_, err := client.MetafieldDefinition.Create(context.Background(), MetafieldDefinitionInput{
+			Name:      "Field",
+			Key:       "field",
+			OwnerType: MetafieldOwnerTypeProduct,
+			Type:      metafieldType,
+		})
+		if err != nil {
+			t.Errorf("MetafieldDefinition.Create returned error for %s: %v", metafieldType, err)
+		}
+	}
+
+	expectedTypes := []string{"id", "list.company_reference"}
+	if !reflect.DeepEqual(types, expectedTypes) {
+		t.Errorf("MetafieldDefinition.Create sent types %+v, expected %+v", types, expectedTypes)
+	}
+}
+
+func TestMetafieldDefinitionUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["definition"])
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionUpdate":{
+				"updatedDefinition":{"id":"gid://shopify/MetafieldDefinition/1","name":"Stars"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	pin := false
+	definition, err := client.MetafieldDefinition.Update(context.Background(), MetafieldDefinitionUpdateInput{
+		Namespace: "reviews",
+		Key:       "rating",
+		OwnerType: MetafieldOwnerTypeProduct,
+		Name:      "Stars",
+		Pin:       &pin,
+	})
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.Update returned error: %v", err)
+	}
+
+	expectedInput := `{"namespace":"reviews","key":"rating","ownerType":"PRODUCT","name":"Stars","pin":false}`
+	if input != expectedInput {
+		t.Errorf("MetafieldDefinition.Update sent %s, expected %s", input, expectedInput)
+	}
+
+	if definition.Name != "Stars" {
+		t.Errorf("MetafieldDefinition.Update returned %+v", definition)
+	}
+}
+
+func TestMetafieldDefinitionDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, v := graphQLRequestBody(req)
+			vars = map[string]string{"id": string(v["id"]), "deleteAllAssociatedMetafields": string(v["deleteAllAssociatedMetafields"])}
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionDelete":{
+				"deletedDefinitionId":"gid://shopify/MetafieldDefinition/1",
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	err := client.MetafieldDefinition.Delete(context.Background(), NewGID(GIDResourceMetafieldDefinition, 1), true)
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.Delete returned error: %v", err)
+	}
+
+	expectedVars := map[string]string{"id": `"gid://shopify/MetafieldDefinition/1"`, "deleteAllAssociatedMetafields": "true"}
+	if !reflect.DeepEqual(vars, expectedVars) {
+		t.Errorf("MetafieldDefinition.Delete sent %+v, expected %+v", vars, expectedVars)
+	}
+}
+
+func TestMetafieldDefinitionPinUnpin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query, _ := graphQLRequestBody(req)
+			if GraphQLOperationName(query) == "metafieldDefinitionPin" {
+				return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionPin":{
+					"pinnedDefinition":{"id":"gid://shopify/MetafieldDefinition/1","pinnedPosition":3},
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldDefinitionUnpin":{
+				"unpinnedDefinition":null,
+				"userErrors":[{"field":["definitionId"],"message":"Definition is not pinned"}]
+			}}}`), nil
+		},
+	)
+
+	definition, err := client.MetafieldDefinition.Pin(context.Background(), NewGID(GIDResourceMetafieldDefinition, 1))
+	if err != nil {
+		t.Fatalf("MetafieldDefinition.Pin returned error: %v", err)
+	}
+	if definition.PinnedPosition == nil || *definition.PinnedPosition != 3 {
+		t.Errorf("MetafieldDefinition.Pin returned %+v", definition)
+	}
+
+	_, err = client.MetafieldDefinition.Unpin(context.Background(), NewGID(GIDResourceMetafieldDefinition, 1))
+	expectedErr := "definitionId: Definition is not pinned"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("MetafieldDefinition.Unpin returned error %v, expected %s", err, expectedErr)
+	}
+}
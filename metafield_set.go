@@ -0,0 +1,229 @@
+package goshopify
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// metafieldsSetLimit is the maximum number of metafields the metafieldsSet
+// mutation accepts per call
+const metafieldsSetLimit = 25
+
+// MetafieldsSetService is an interface for creating and updating metafields
+// of any owner through the metafieldsSet mutation of the graphql endpoint of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-graphql/latest/mutations/metafieldsSet
+type MetafieldsSetService interface {
+	Set(context.Context, []MetafieldInput) ([]Metafield, error)
+}
+
+// MetafieldsSetServiceOp handles communication with the metafieldsSet
+// mutation of the Shopify API.
+type MetafieldsSetServiceOp struct {
+	client *Client
+}
+
+// metafieldOwnerResources maps the resource of an owner global id to the
+// owner_resource of the REST API
+var metafieldOwnerResources = map[GIDResourceType]string{
+	GIDResourceArticle:        "article",
+	GIDResourceBlog:           "blog",
+	GIDResourceCollection:     "collection",
+	GIDResourceCustomer:       "customer",
+	GIDResourceDraftOrder:     "draft_order",
+	GIDResourceLocation:       "location",
+	GIDResourceOrder:          "order",
+	GIDResourcePage:           "page",
+	GIDResourceProduct:        "product",
+	GIDResourceProductImage:   "product_image",
+	GIDResourceProductVariant: "variant",
+	GIDResourceShop:           "shop",
+}
+
+// MetafieldInput represents a metafield to create or update with
+// MetafieldsSetService, the value is the string value of the metafield, see
+// EncodeMetafieldValue
+type MetafieldInput struct {
+	OwnerId   GID           `json:"ownerId"`
+	Namespace string        `json:"namespace,omitempty"`
+	Key       string        `json:"key"`
+	Type      MetafieldType `json:"type,omitempty"`
+	Value     string        `json:"value"`
+}
+
+// MetafieldsSetItemError is an error of a single input of MetafieldsSetService
+type MetafieldsSetItemError struct {
+	// Index is the index of the input in the slice passed to Set,
+	// or -1 if the error is not related to an input
+	Index   int
+	Field   []string
+	Message string
+	Code    string
+}
+
+func (e MetafieldsSetItemError) Error() string {
+	if e.Index < 0 {
+		return GraphQLUserError{Field: e.Field, Message: e.Message}.Error()
+	}
+	return GraphQLUserError{
+		Field:   append([]string{"metafields", strconv.Itoa(e.Index)}, e.Field...),
+		Message: e.Message,
+	}.Error()
+}
+
+// MetafieldsSetError is returned by MetafieldsSetService when some of the
+// inputs were not set. Embeds the ResponseError to allow consumers to handle
+// it the same way as a normal ResponseError.
+type MetafieldsSetError struct {
+	ResponseError
+	Items []MetafieldsSetItemError
+
+	// Unsent is the index of the first input that was not sent, the inputs
+	// from there on were not set. Equals the number of inputs if all of them
+	// were sent.
+	Unsent int
+
+	// Err is the error that stopped the remaining inputs from being sent, if
+	// any
+	Err error
+}
+
+// Unwrap returns the error that stopped the remaining inputs from being sent
+func (e MetafieldsSetError) Unwrap() error {
+	return e.Err
+}
+
+// metafieldsSetNode represents a metafield returned by the metafieldsSet
+// mutation
+type metafieldsSetNode struct {
+	Id        GID           `json:"id"`
+	Namespace string        `json:"namespace"`
+	Key       string        `json:"key"`
+	Type      MetafieldType `json:"type"`
+	Value     string        `json:"value"`
+	CreatedAt *time.Time    `json:"createdAt"`
+	UpdatedAt *time.Time    `json:"updatedAt"`
+	Owner     struct {
+		Id GID `json:"id"`
+	} `json:"owner"`
+}
+
+func (n metafieldsSetNode) metafield() Metafield {
+	return Metafield{
+		Id:                n.Id.Id,
+		AdminGraphqlApiId: n.Id.String(),
+		Namespace:         n.Namespace,
+		Key:               n.Key,
+		Type:              n.Type,
+		Value:             n.Value,
+		OwnerId:           n.Owner.Id.Id,
+		OwnerResource:     metafieldOwnerResources[n.Owner.Id.Resource],
+		CreatedAt:         n.CreatedAt,
+		UpdatedAt:         n.UpdatedAt,
+	}
+}
+
+const metafieldsSetMutation = `mutation metafieldsSet($metafields: [MetafieldsSetInput!]!) {
+  metafieldsSet(metafields: $metafields) {
+    metafields {
+      id
+      namespace
+      key
+      type
+      value
+      createdAt
+      updatedAt
+      owner {
+        ... on Node {
+          id
+        }
+      }
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// Set creates or updates metafields of any owner, batching the inputs 25 at
+// a time. The values are validated against their types before anything is
+// sent. A batch is set atomically, if any of its inputs fails none of them
+// are set, but the other batches are still sent. The metafields that were
+// set are returned along with a MetafieldsSetError for the failed inputs.
+// A request error stops the remaining batches, it is returned as is unless
+// earlier batches failed, in which case it is the Err of the
+// MetafieldsSetError.
+func (s *MetafieldsSetServiceOp) Set(ctx context.Context, metafields []MetafieldInput) ([]Metafield, error) {
+	var itemErrors []MetafieldsSetItemError
+	for i, metafield := range metafields {
+		if metafield.OwnerId.IsZero() {
+			itemErrors = append(itemErrors, MetafieldsSetItemError{Index: i, Field: []string{"ownerId"}, Message: "is required"})
+			continue
+		}
+		if err := ValidateMetafieldValue(metafield.Type, metafield.Value); err != nil {
+			itemErrors = append(itemErrors, MetafieldsSetItemError{Index: i, Field: []string{"value"}, Message: err.Error()})
+		}
+	}
+	if len(itemErrors) > 0 {
+		return nil, newMetafieldsSetError(itemErrors, 0, nil)
+	}
+
+	collector := []Metafield{}
+	for offset := 0; offset < len(metafields); offset += metafieldsSetLimit {
+		end := offset + metafieldsSetLimit
+		if end > len(metafields) {
+			end = len(metafields)
+		}
+
+		vars := map[string]interface{}{"metafields": metafields[offset:end]}
+		resp := struct {
+			MetafieldsSet struct {
+				Metafields []metafieldsSetNode `json:"metafields"`
+				UserErrors []GraphQLUserError  `json:"userErrors"`
+			} `json:"metafieldsSet"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, metafieldsSetMutation, vars, &resp)
+		if err != nil {
+			if len(itemErrors) > 0 {
+				return collector, newMetafieldsSetError(itemErrors, offset, err)
+			}
+			return collector, err
+		}
+
+		for _, node := range resp.MetafieldsSet.Metafields {
+			collector = append(collector, node.metafield())
+		}
+
+		for _, userErr := range resp.MetafieldsSet.UserErrors {
+			itemErrors = append(itemErrors, metafieldsSetItemError(userErr, offset))
+		}
+	}
+
+	if len(itemErrors) > 0 {
+		return collector, newMetafieldsSetError(itemErrors, len(metafields), nil)
+	}
+
+	return collector, nil
+}
+
+// metafieldsSetItemError maps a user error of a batch back to the input it
+// refers to
+func metafieldsSetItemError(userErr GraphQLUserError, offset int) MetafieldsSetItemError {
+	index, field := batchUserErrorIndex(userErr, "metafields", offset)
+	return MetafieldsSetItemError{Index: index, Field: field, Message: userErr.Message, Code: userErr.Code}
+}
+
+func newMetafieldsSetError(items []MetafieldsSetItemError, unsent int, err error) error {
+	setErr := MetafieldsSetError{ResponseError: ResponseError{Status: 200}, Items: items, Unsent: unsent, Err: err}
+	for _, item := range items {
+		setErr.Errors = append(setErr.Errors, item.Error())
+	}
+	if err != nil {
+		setErr.Errors = append(setErr.Errors, err.Error())
+	}
+	return setErr
+}
@@ -0,0 +1,247 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMetafieldsSet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batches [][]MetafieldInput
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			var batch []MetafieldInput
+			if err := json.Unmarshal(vars["metafields"], &batch); err != nil {
+				return nil, err
+			}
+			batches = append(batches, batch)
+			if len(batches) == 1 {
+				return httpmock.NewStringResponse(200, `{"data":{"metafieldsSet":{
+					"metafields":[{
+						"id":"gid://shopify/Metafield/1",
+						"namespace":"custom",
+						"key":"fabric",
+						"type":"single_line_text_field",
+						"value":"cotton",
+						"createdAt":"2024-01-02T09:00:00Z",
+						"updatedAt":"2024-01-02T09:00:00Z",
+						"owner":{"id":"gid://shopify/ProductVariant/2"}
+					}],
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldsSet":{
+				"metafields":[{
+					"id":"gid://shopify/Metafield/3",
+					"namespace":"custom",
+					"key":"vip",
+					"type":"boolean",
+					"value":"true",
+					"owner":{"id":"gid://shopify/Customer/4"}
+				}],
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	inputs := make([]MetafieldInput, 0, 30)
+	for i := 0; i < 29; i++ {
+		inputs = append(inputs, MetafieldInput{
+			OwnerId:   NewGID(GIDResourceProductVariant, uint64(i+1)),
+			Namespace: "custom",
+			Key:       "fabric",
+			Type:      MetafieldTypeSingleLineTextField,
+			Value:     "cotton",
+		})
+	}
+	inputs = append(inputs, MetafieldInput{
+		OwnerId:   NewGID(GIDResourceCustomer, 4),
+		Namespace: "custom",
+		Key:       "vip",
+		Type:      MetafieldTypeBoolean,
+		Value:     "true",
+	})
+
+	metafields, err := client.MetafieldsSet.Set(context.Background(), inputs)
+	if err != nil {
+		t.Fatalf("MetafieldsSet.Set returned error: %v", err)
+	}
+
+	if len(batches) != 2 || len(batches[0]) != 25 || len(batches[1]) != 5 {
+		t.Fatalf("MetafieldsSet.Set sent %d batches, expected batches of 25 and 5", len(batches))
+	}
+	if !reflect.DeepEqual(batches[1][4], inputs[29]) {
+		t.Errorf("MetafieldsSet.Set sent %+v, expected %+v", batches[1][4], inputs[29])
+	}
+
+	createdAt := time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)
+	expected := []Metafield{
+		{
+			Id:                1,
+			AdminGraphqlApiId: "gid://shopify/Metafield/1",
+			Namespace:         "custom",
+			Key:               "fabric",
+			Type:              MetafieldTypeSingleLineTextField,
+			Value:             "cotton",
+			OwnerId:           2,
+			OwnerResource:     "variant",
+			CreatedAt:         &createdAt,
+			UpdatedAt:         &createdAt,
+		},
+		{
+			Id:                3,
+			AdminGraphqlApiId: "gid://shopify/Metafield/3",
+			Namespace:         "custom",
+			Key:               "vip",
+			Type:              MetafieldTypeBoolean,
+			Value:             "true",
+			OwnerId:           4,
+			OwnerResource:     "customer",
+		},
+	}
+	if !reflect.DeepEqual(metafields, expected) {
+		t.Errorf("MetafieldsSet.Set returned %+v, expected %+v", metafields, expected)
+	}
+}
+
+func TestMetafieldsSetUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return httpmock.NewStringResponse(200, `{"data":{"metafieldsSet":{
+					"metafields":[{"id":"gid://shopify/Metafield/1","owner":{"id":"gid://shopify/Shop/1"}}],
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metafieldsSet":{
+				"metafields":[],
+				"userErrors":[{"field":["metafields","2","key"],"message":"Key is too short","code":"TOO_SHORT"}]
+			}}}`), nil
+		},
+	)
+
+	inputs := make([]MetafieldInput, 30)
+	for i := range inputs {
+		inputs[i] = MetafieldInput{OwnerId: NewGID(GIDResourceShop, 1), Namespace: "custom", Key: "note", Value: "hello"}
+	}
+
+	metafields, err := client.MetafieldsSet.Set(context.Background(), inputs)
+
+	var setErr MetafieldsSetError
+	if !errors.As(err, &setErr) {
+		t.Fatalf("MetafieldsSet.Set returned error %v, expected a MetafieldsSetError", err)
+	}
+
+	expectedItems := []MetafieldsSetItemError{{Index: 27, Field: []string{"key"}, Message: "Key is too short", Code: "TOO_SHORT"}}
+	if !reflect.DeepEqual(setErr.Items, expectedItems) {
+		t.Errorf("MetafieldsSet.Set returned items %+v, expected %+v", setErr.Items, expectedItems)
+	}
+
+	expectedErr := "metafields.27.key: Key is too short"
+	if err.Error() != expectedErr {
+		t.Errorf("MetafieldsSet.Set returned error %s, expected %s", err, expectedErr)
+	}
+
+	if setErr.Unsent != 30 || setErr.Err != nil {
+		t.Errorf("MetafieldsSet.Set returned unsent %d and error %v, expected all inputs sent", setErr.Unsent, setErr.Err)
+	}
+
+	if len(metafields) != 1 || metafields[0].OwnerResource != "shop" {
+		t.Errorf("MetafieldsSet.Set returned %+v, expected the metafields of the first batch", metafields)
+	}
+}
+
+func TestMetafieldsSetRequestErrorAfterUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 1 {
+				return httpmock.NewStringResponse(200, `{"data":{"metafieldsSet":{
+					"metafields":[],
+					"userErrors":[{"field":["metafields","2","key"],"message":"Key is too short","code":"TOO_SHORT"}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(500, `{"errors":"Internal Server Error"}`), nil
+		},
+	)
+
+	inputs := make([]MetafieldInput, 60)
+	for i := range inputs {
+		inputs[i] = MetafieldInput{OwnerId: NewGID(GIDResourceShop, 1), Namespace: "custom", Key: "note", Value: "hello"}
+	}
+
+	_, err := client.MetafieldsSet.Set(context.Background(), inputs)
+
+	var setErr MetafieldsSetError
+	if !errors.As(err, &setErr) {
+		t.Fatalf("MetafieldsSet.Set returned error %v, expected a MetafieldsSetError", err)
+	}
+
+	expectedItems := []MetafieldsSetItemError{{Index: 2, Field: []string{"key"}, Message: "Key is too short", Code: "TOO_SHORT"}}
+	if !reflect.DeepEqual(setErr.Items, expectedItems) {
+		t.Errorf("MetafieldsSet.Set returned items %+v, expected %+v", setErr.Items, expectedItems)
+	}
+
+	if setErr.Unsent != 25 {
+		t.Errorf("MetafieldsSet.Set returned unsent %d, expected 25", setErr.Unsent)
+	}
+
+	var responseErr ResponseError
+	if !errors.As(setErr.Unwrap(), &responseErr) || responseErr.Status != 500 {
+		t.Errorf("MetafieldsSet.Set returned request error %v, expected a 500 ResponseError", setErr.Err)
+	}
+
+	if calls != 2 {
+		t.Errorf("MetafieldsSet.Set made %d requests, expected 2", calls)
+	}
+}
+
+func TestMetafieldsSetInvalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.MetafieldsSet.Set(context.Background(), []MetafieldInput{
+		{OwnerId: NewGID(GIDResourceProduct, 1), Key: "count", Type: MetafieldTypeNumberInteger, Value: "1"},
+		{Key: "count", Type: MetafieldTypeNumberInteger, Value: "2"},
+		{OwnerId: NewGID(GIDResourceProduct, 1), Key: "count", Type: MetafieldTypeNumberInteger, Value: "many"},
+	})
+
+	var setErr MetafieldsSetError
+	if !errors.As(err, &setErr) {
+		t.Fatalf("MetafieldsSet.Set returned error %v, expected a MetafieldsSetError", err)
+	}
+
+	if len(setErr.Items) != 2 || setErr.Items[0].Index != 1 || setErr.Items[1].Index != 2 {
+		t.Errorf("MetafieldsSet.Set returned items %+v, expected errors for inputs 1 and 2", setErr.Items)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("MetafieldsSet.Set made %d requests, expected none", calls)
+	}
+}
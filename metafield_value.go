@@ -0,0 +1,404 @@
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// metafieldDateTimeLayout is the layout Shopify uses for date_time values
+// without a time zone
+const metafieldDateTimeLayout = "2006-01-02T15:04:05"
+
+// MetafieldMoney represents the value of a money metafield
+type MetafieldMoney struct {
+	Amount       decimal.Decimal `json:"amount"`
+	CurrencyCode string          `json:"currency_code"`
+}
+
+// MetafieldMeasurement represents the value of a dimension, volume or weight
+// metafield, e.g. {"value": 25.0, "unit": "cm"}
+type MetafieldMeasurement struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// MetafieldRating represents the value of a rating metafield
+type MetafieldRating struct {
+	Value    decimal.Decimal `json:"value"`
+	ScaleMin decimal.Decimal `json:"scale_min"`
+	ScaleMax decimal.Decimal `json:"scale_max"`
+}
+
+// MetafieldLink represents the value of a link metafield
+type MetafieldLink struct {
+	Text string `json:"text"`
+	Url  string `json:"url"`
+}
+
+// metafieldValueTypes maps each known metafield type to the Go type its
+// values are decoded to, the elements of list types use the same mapping
+var metafieldValueTypes = map[MetafieldType]reflect.Type{
+	MetafieldTypeSingleLineTextField: reflect.TypeOf(""),
+	MetafieldTypeMultiLineTextField:  reflect.TypeOf(""),
+	MetafieldTypeColor:               reflect.TypeOf(""),
+	MetafieldTypeURL:                 reflect.TypeOf(""),
+	MetafieldTypeBoolean:             reflect.TypeOf(false),
+	MetafieldTypeNumberInteger:       reflect.TypeOf(int64(0)),
+	MetafieldTypeNumberDecimal:       reflect.TypeOf(decimal.Decimal{}),
+	MetafieldTypeDate:                reflect.TypeOf(time.Time{}),
+	MetafieldTypeDatetime:            reflect.TypeOf(time.Time{}),
+	MetafieldTypeDimension:           reflect.TypeOf(MetafieldMeasurement{}),
+	MetafieldTypeVolume:              reflect.TypeOf(MetafieldMeasurement{}),
+	MetafieldTypeWeight:              reflect.TypeOf(MetafieldMeasurement{}),
+	MetafieldTypeMoney:               reflect.TypeOf(MetafieldMoney{}),
+	MetafieldTypeRating:              reflect.TypeOf(MetafieldRating{}),
+	MetafieldTypeLink:                reflect.TypeOf(MetafieldLink{}),
+	MetafieldTypeJSON:                reflect.TypeOf(json.RawMessage{}),
+	MetafieldTypeRichTextField:       reflect.TypeOf(json.RawMessage{}),
+	MetafieldTypeCollectionReference: reflect.TypeOf(GID{}),
+	MetafieldTypeCustomerReference:   reflect.TypeOf(GID{}),
+	MetafieldTypeFileReference:       reflect.TypeOf(GID{}),
+	MetafieldTypeMetaobjectReference: reflect.TypeOf(GID{}),
+	MetafieldTypeMixedReference:      reflect.TypeOf(GID{}),
+	MetafieldTypePageReference:       reflect.TypeOf(GID{}),
+	MetafieldTypeProductReference:    reflect.TypeOf(GID{}),
+	MetafieldTypeVariantReference:    reflect.TypeOf(GID{}),
+}
+
+// metafieldReferenceResources maps reference types to the resource their
+// global ids must refer to, types missing here accept any resource
+var metafieldReferenceResources = map[MetafieldType]GIDResourceType{
+	MetafieldTypeCollectionReference: GIDResourceCollection,
+	MetafieldTypeCustomerReference:   GIDResourceCustomer,
+	MetafieldTypeMetaobjectReference: GIDResourceMetaobject,
+	MetafieldTypeMixedReference:      GIDResourceMetaobject,
+	MetafieldTypePageReference:       GIDResourcePage,
+	MetafieldTypeProductReference:    GIDResourceProduct,
+	MetafieldTypeVariantReference:    GIDResourceProductVariant,
+}
+
+// IsKnownMetafieldType reports whether the codec supports the type, legacy
+// types such as "integer" or "string" are not supported
+func IsKnownMetafieldType(t MetafieldType) bool {
+	_, ok := metafieldValueTypes[t.ElementType()]
+	return ok
+}
+
+// DecodeMetafieldValue decodes a metafield value into the Go type of its
+// metafield type. Text types decode to string, boolean to bool,
+// number_integer to int64, number_decimal to decimal.Decimal, date and
+// date_time to time.Time, references to GID, json and rich_text_field to
+// json.RawMessage and the measurement, money, rating and link types to their
+// Metafield structs. List types decode to a slice of the element type.
+func DecodeMetafieldValue(t MetafieldType, value string) (interface{}, error) {
+	elementType := t.ElementType()
+	goType, ok := metafieldValueTypes[elementType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported metafield type %q", t)
+	}
+
+	if !t.IsList() {
+		v, err := decodeMetafieldScalar(elementType, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s metafield value %q: %w", t, value, err)
+		}
+		return v, nil
+	}
+
+	var elements []json.RawMessage
+	if err := json.Unmarshal([]byte(value), &elements); err != nil {
+		return nil, fmt.Errorf("invalid %s metafield value %q: %w", t, value, err)
+	}
+
+	list := reflect.MakeSlice(reflect.SliceOf(goType), 0, len(elements))
+	for i, element := range elements {
+		s := string(element)
+		if len(element) > 0 && element[0] == '"' {
+			if err := json.Unmarshal(element, &s); err != nil {
+				return nil, err
+			}
+		}
+
+		v, err := decodeMetafieldScalar(elementType, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s metafield value %q at index %d: %w", t, value, i, err)
+		}
+		list = reflect.Append(list, reflect.ValueOf(v))
+	}
+
+	return list.Interface(), nil
+}
+
+func decodeMetafieldScalar(t MetafieldType, value string) (interface{}, error) {
+	switch t {
+	case MetafieldTypeBoolean:
+		return strconv.ParseBool(value)
+	case MetafieldTypeNumberInteger:
+		return strconv.ParseInt(value, 10, 64)
+	case MetafieldTypeNumberDecimal:
+		return decimal.NewFromString(value)
+	case MetafieldTypeDate:
+		return time.Parse("2006-01-02", value)
+	case MetafieldTypeDatetime:
+		if v, err := time.Parse(time.RFC3339, value); err == nil {
+			return v, nil
+		}
+		return time.Parse(metafieldDateTimeLayout, value)
+	case MetafieldTypeJSON, MetafieldTypeRichTextField:
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("value is not valid JSON")
+		}
+		return json.RawMessage(value), nil
+	}
+
+	goType := metafieldValueTypes[t]
+
+	if goType == reflect.TypeOf(GID{}) {
+		gid, err := ParseGID(value)
+		if err != nil {
+			return nil, err
+		}
+		if resource, ok := metafieldReferenceResources[t]; ok {
+			if err := gid.Validate(resource); err != nil {
+				return nil, err
+			}
+		}
+		return gid, nil
+	}
+
+	if goType.Kind() == reflect.Struct {
+		v := reflect.New(goType)
+		if err := json.Unmarshal([]byte(value), v.Interface()); err != nil {
+			return nil, err
+		}
+		return v.Elem().Interface(), nil
+	}
+
+	return value, nil
+}
+
+// EncodeMetafieldValue encodes a Go value into the string value of a
+// metafield of the given type. It accepts the types DecodeMetafieldValue
+// returns, as well as strings that are valid values, any integer type for
+// number_integer, floats for number_decimal and OnlyDate for date. List
+// types accept a slice of any of the accepted element types.
+func EncodeMetafieldValue(t MetafieldType, v interface{}) (string, error) {
+	elementType := t.ElementType()
+	if _, ok := metafieldValueTypes[elementType]; !ok {
+		return "", fmt.Errorf("unsupported metafield type %q", t)
+	}
+
+	if !t.IsList() {
+		s, err := encodeMetafieldScalar(elementType, v)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s metafield value %v: %w", t, v, err)
+		}
+		return s, nil
+	}
+
+	if s, ok := v.(string); ok {
+		if _, err := DecodeMetafieldValue(t, s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return "", fmt.Errorf("invalid %s metafield value %v: expected a slice", t, v)
+	}
+
+	elements := make([]json.RawMessage, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		s, err := encodeMetafieldScalar(elementType, rv.Index(i).Interface())
+		if err != nil {
+			return "", fmt.Errorf("invalid %s metafield value at index %d: %w", t, i, err)
+		}
+
+		element := json.RawMessage(s)
+		if !metafieldListElementIsJSON(elementType) {
+			b, err := json.Marshal(s)
+			if err != nil {
+				return "", err
+			}
+			element = b
+		}
+		elements = append(elements, element)
+	}
+
+	b, err := json.Marshal(elements)
+	return string(b), err
+}
+
+// metafieldListElementIsJSON reports whether the elements of a list of the
+// given type are stored as JSON numbers or objects rather than strings
+func metafieldListElementIsJSON(t MetafieldType) bool {
+	switch metafieldValueTypes[t] {
+	case reflect.TypeOf(int64(0)), reflect.TypeOf(decimal.Decimal{}), reflect.TypeOf(MetafieldMeasurement{}),
+		reflect.TypeOf(MetafieldMoney{}), reflect.TypeOf(MetafieldRating{}), reflect.TypeOf(MetafieldLink{}):
+		return true
+	}
+	return false
+}
+
+func encodeMetafieldScalar(t MetafieldType, v interface{}) (string, error) {
+	if v == nil {
+		return "", fmt.Errorf("value is nil")
+	}
+
+	if s, ok := v.(string); ok {
+		if _, err := decodeMetafieldScalar(t, s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+
+	switch t {
+	case MetafieldTypeBoolean:
+		if b, ok := v.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+	case MetafieldTypeNumberInteger:
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(rv.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(rv.Uint(), 10), nil
+		case reflect.Float32, reflect.Float64:
+			// values decoded from JSON into an interface{} are floats
+			if f := rv.Float(); f == float64(int64(f)) {
+				return strconv.FormatInt(int64(f), 10), nil
+			}
+		}
+	case MetafieldTypeNumberDecimal:
+		switch d := v.(type) {
+		case decimal.Decimal:
+			return d.String(), nil
+		case *decimal.Decimal:
+			if d != nil {
+				return d.String(), nil
+			}
+		case float64:
+			return decimal.NewFromFloat(d).String(), nil
+		case float32:
+			return decimal.NewFromFloat32(d).String(), nil
+		case int:
+			return strconv.Itoa(d), nil
+		case int64:
+			return strconv.FormatInt(d, 10), nil
+		}
+	case MetafieldTypeDate:
+		switch d := v.(type) {
+		case time.Time:
+			return d.Format("2006-01-02"), nil
+		case OnlyDate:
+			return d.Format("2006-01-02"), nil
+		case *OnlyDate:
+			if d != nil {
+				return d.Format("2006-01-02"), nil
+			}
+		}
+	case MetafieldTypeDatetime:
+		switch d := v.(type) {
+		case time.Time:
+			return d.Format(time.RFC3339), nil
+		case *time.Time:
+			if d != nil {
+				return d.Format(time.RFC3339), nil
+			}
+		}
+	case MetafieldTypeJSON, MetafieldTypeRichTextField:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+
+	goType := metafieldValueTypes[t]
+
+	if goType == reflect.TypeOf(GID{}) {
+		var gid GID
+		switch g := v.(type) {
+		case GID:
+			gid = g
+		case *GID:
+			if g != nil {
+				gid = *g
+			}
+		}
+		if gid.IsZero() {
+			return "", fmt.Errorf("expected a GID, got %T", v)
+		}
+		if resource, ok := metafieldReferenceResources[t]; ok {
+			if err := gid.Validate(resource); err != nil {
+				return "", err
+			}
+		}
+		return gid.String(), nil
+	}
+
+	if goType.Kind() == reflect.Struct && goType != reflect.TypeOf(time.Time{}) {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			rv = rv.Elem()
+		}
+		if rv.Type() == goType || rv.Kind() == reflect.Map {
+			b, err := json.Marshal(rv.Interface())
+			if err != nil {
+				return "", err
+			}
+			// validate maps against the struct, e.g. a money value without
+			// an amount
+			if _, err := decodeMetafieldScalar(t, string(b)); err != nil {
+				return "", err
+			}
+			return string(bytes.TrimSpace(b)), nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported Go type %T", v)
+}
+
+// ValidateMetafieldValue returns an error if the value is not a valid value
+// of the metafield type. Values of types unknown to the codec, e.g. the
+// legacy integer and string types, are not validated.
+func ValidateMetafieldValue(t MetafieldType, v interface{}) error {
+	if !IsKnownMetafieldType(t) || v == nil {
+		return nil
+	}
+	_, err := EncodeMetafieldValue(t, v)
+	return err
+}
+
+// DecodeValue decodes the value of the metafield according to its type, see
+// DecodeMetafieldValue
+func (m Metafield) DecodeValue() (interface{}, error) {
+	switch v := m.Value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return DecodeMetafieldValue(m.Type, v)
+	default:
+		s, err := EncodeMetafieldValue(m.Type, v)
+		if err != nil {
+			return nil, err
+		}
+		return DecodeMetafieldValue(m.Type, s)
+	}
+}
+
+// EncodeValue sets the value of the metafield from a Go value according to
+// its type, see EncodeMetafieldValue
+func (m *Metafield) EncodeValue(v interface{}) error {
+	s, err := EncodeMetafieldValue(m.Type, v)
+	if err != nil {
+		return err
+	}
+	m.Value = s
+	return nil
+}
@@ -0,0 +1,152 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMetafieldTypeList(t *testing.T) {
+	listType := MetafieldTypeProductReference.List()
+	if listType != "list.product_reference" {
+		t.Errorf("MetafieldType.List returned %s, expected list.product_reference", listType)
+	}
+	if !listType.IsList() || MetafieldTypeProductReference.IsList() {
+		t.Errorf("MetafieldType.IsList returned unexpected result for %s", listType)
+	}
+	if listType.ElementType() != MetafieldTypeProductReference {
+		t.Errorf("MetafieldType.ElementType returned %s, expected %s", listType.ElementType(), MetafieldTypeProductReference)
+	}
+	if listType.List() != listType {
+		t.Errorf("MetafieldType.List of a list returned %s, expected %s", listType.List(), listType)
+	}
+}
+
+func TestDecodeMetafieldValue(t *testing.T) {
+	cases := []struct {
+		metafieldType MetafieldType
+		value         string
+		expected      interface{}
+	}{
+		{MetafieldTypeSingleLineTextField, "foo", "foo"},
+		{MetafieldTypeBoolean, "true", true},
+		{MetafieldTypeNumberInteger, "25", int64(25)},
+		{MetafieldTypeNumberDecimal, "9.50", decimal.RequireFromString("9.50")},
+		{MetafieldTypeDate, "2024-01-02", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{MetafieldTypeDatetime, "2024-01-02T09:30:00", time.Date(2024, 1, 2, 9, 30, 0, 0, time.UTC)},
+		{MetafieldTypeJSON, `{"a":1}`, json.RawMessage(`{"a":1}`)},
+		{MetafieldTypeProductReference, "gid://shopify/Product/1", NewGID(GIDResourceProduct, 1)},
+		{MetafieldTypeFileReference, "gid://shopify/MediaImage/1", NewGID("MediaImage", 1)},
+		{MetafieldTypeWeight, `{"value":2.5,"unit":"KILOGRAMS"}`, MetafieldMeasurement{Value: 2.5, Unit: "KILOGRAMS"}},
+		{MetafieldTypeMoney, `{"amount":"5.99","currency_code":"CAD"}`, MetafieldMoney{Amount: decimal.RequireFromString("5.99"), CurrencyCode: "CAD"}},
+		{MetafieldTypeLink, `{"text":"Shop","url":"https://example.com"}`, MetafieldLink{Text: "Shop", Url: "https://example.com"}},
+		{MetafieldTypeSingleLineTextField.List(), `["a","b"]`, []string{"a", "b"}},
+		{MetafieldTypeNumberInteger.List(), `[1,2]`, []int64{1, 2}},
+		{MetafieldTypeVariantReference.List(), `["gid://shopify/ProductVariant/1"]`, []GID{NewGID(GIDResourceProductVariant, 1)}},
+	}
+
+	for _, c := range cases {
+		v, err := DecodeMetafieldValue(c.metafieldType, c.value)
+		if err != nil {
+			t.Errorf("DecodeMetafieldValue(%s, %q) returned error: %v", c.metafieldType, c.value, err)
+			continue
+		}
+		if !reflect.DeepEqual(v, c.expected) {
+			t.Errorf("DecodeMetafieldValue(%s, %q) returned %#v, expected %#v", c.metafieldType, c.value, v, c.expected)
+		}
+	}
+}
+
+func TestDecodeMetafieldValueError(t *testing.T) {
+	cases := []struct {
+		metafieldType MetafieldType
+		value         string
+	}{
+		{MetafieldTypeBoolean, "yes"},
+		{MetafieldTypeNumberInteger, "2.5"},
+		{MetafieldTypeJSON, "{"},
+		{MetafieldTypeProductReference, "gid://shopify/Collection/1"},
+		{MetafieldTypeNumberInteger.List(), `[1,"a"]`},
+		{"integer", "1"},
+	}
+
+	for _, c := range cases {
+		if _, err := DecodeMetafieldValue(c.metafieldType, c.value); err == nil {
+			t.Errorf("DecodeMetafieldValue(%s, %q) expected an error", c.metafieldType, c.value)
+		}
+	}
+}
+
+func TestEncodeMetafieldValue(t *testing.T) {
+	cases := []struct {
+		metafieldType MetafieldType
+		value         interface{}
+		expected      string
+	}{
+		{MetafieldTypeSingleLineTextField, "foo", "foo"},
+		{MetafieldTypeBoolean, false, "false"},
+		{MetafieldTypeNumberInteger, 25, "25"},
+		{MetafieldTypeNumberInteger, float64(25), "25"},
+		{MetafieldTypeNumberDecimal, decimal.RequireFromString("9.5"), "9.5"},
+		{MetafieldTypeDate, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), "2024-01-02"},
+		{MetafieldTypeDatetime, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), "2024-01-02T09:00:00Z"},
+		{MetafieldTypeJSON, map[string]int{"a": 1}, `{"a":1}`},
+		{MetafieldTypeCollectionReference, NewGID(GIDResourceCollection, 3), "gid://shopify/Collection/3"},
+		{MetafieldTypeDimension, MetafieldMeasurement{Value: 10, Unit: "CENTIMETERS"}, `{"value":10,"unit":"CENTIMETERS"}`},
+		{MetafieldTypeMoney, map[string]string{"amount": "5.99", "currency_code": "CAD"}, `{"amount":"5.99","currency_code":"CAD"}`},
+		{MetafieldTypeSingleLineTextField.List(), []string{"a", "b"}, `["a","b"]`},
+		{MetafieldTypeNumberInteger.List(), []int{1, 2}, `[1,2]`},
+		{MetafieldTypeProductReference.List(), []GID{NewGID(GIDResourceProduct, 1)}, `["gid://shopify/Product/1"]`},
+		{MetafieldTypeSingleLineTextField.List(), `["a"]`, `["a"]`},
+	}
+
+	for _, c := range cases {
+		s, err := EncodeMetafieldValue(c.metafieldType, c.value)
+		if err != nil {
+			t.Errorf("EncodeMetafieldValue(%s, %v) returned error: %v", c.metafieldType, c.value, err)
+			continue
+		}
+		if s != c.expected {
+			t.Errorf("EncodeMetafieldValue(%s, %v) returned %s, expected %s", c.metafieldType, c.value, s, c.expected)
+		}
+	}
+}
+
+func TestValidateMetafieldValue(t *testing.T) {
+	if err := ValidateMetafieldValue(MetafieldTypeNumberInteger, "abc"); err == nil {
+		t.Errorf("ValidateMetafieldValue expected an error for an invalid number_integer")
+	}
+	if err := ValidateMetafieldValue(MetafieldTypeBoolean, 1); err == nil {
+		t.Errorf("ValidateMetafieldValue expected an error for an int boolean")
+	}
+	if err := ValidateMetafieldValue(MetafieldTypeProductReference, NewGID(GIDResourceOrder, 1)); err == nil {
+		t.Errorf("ValidateMetafieldValue expected an error for an order product_reference")
+	}
+	if err := ValidateMetafieldValue("integer", "abc"); err != nil {
+		t.Errorf("ValidateMetafieldValue returned error for a legacy type: %v", err)
+	}
+}
+
+func TestMetafieldEncodeDecodeValue(t *testing.T) {
+	metafield := Metafield{Type: MetafieldTypeRating}
+	rating := MetafieldRating{
+		Value:    decimal.RequireFromString("3.5"),
+		ScaleMin: decimal.RequireFromString("1"),
+		ScaleMax: decimal.RequireFromString("5"),
+	}
+
+	if err := metafield.EncodeValue(rating); err != nil {
+		t.Fatalf("Metafield.EncodeValue returned error: %v", err)
+	}
+
+	v, err := metafield.DecodeValue()
+	if err != nil {
+		t.Fatalf("Metafield.DecodeValue returned error: %v", err)
+	}
+	if !reflect.DeepEqual(v, rating) {
+		t.Errorf("Metafield.DecodeValue returned %#v, expected %#v", v, rating)
+	}
+}
@@ -0,0 +1,759 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// MetaobjectService is an interface for managing metaobject definitions and
+// entries through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/custom-data/metaobjects
+type MetaobjectService interface {
+	ListDefinitions(context.Context) ([]MetaobjectDefinition, error)
+	GetDefinition(context.Context, GID) (*MetaobjectDefinition, error)
+	GetDefinitionByType(context.Context, string) (*MetaobjectDefinition, error)
+	CreateDefinition(context.Context, MetaobjectDefinitionCreateInput) (*MetaobjectDefinition, error)
+	UpdateDefinition(context.Context, GID, MetaobjectDefinitionUpdateInput) (*MetaobjectDefinition, error)
+	DeleteDefinition(context.Context, GID) error
+	List(context.Context, string, *MetaobjectListOptions) ([]Metaobject, *GraphQLPageInfo, error)
+	ListAll(context.Context, string) ([]Metaobject, error)
+	Get(context.Context, GID) (*Metaobject, error)
+	GetByHandle(context.Context, string, string) (*Metaobject, error)
+	Create(context.Context, MetaobjectCreateInput) (*Metaobject, error)
+	Update(context.Context, GID, MetaobjectUpdateInput) (*Metaobject, error)
+	Upsert(context.Context, string, string, MetaobjectUpsertInput) (*Metaobject, error)
+	Delete(context.Context, GID) error
+}
+
+// MetaobjectServiceOp handles communication with the metaobject related
+// methods of the Shopify API.
+type MetaobjectServiceOp struct {
+	client *Client
+}
+
+// MetaobjectAdminAccess is the access of the admin API and merchants to the
+// metaobjects of a definition
+type MetaobjectAdminAccess string
+
+const (
+	MetaobjectAdminAccessMerchantRead      MetaobjectAdminAccess = "MERCHANT_READ"
+	MetaobjectAdminAccessMerchantReadWrite MetaobjectAdminAccess = "MERCHANT_READ_WRITE"
+)
+
+// MetaobjectStorefrontAccess is the access of the storefront API to the
+// metaobjects of a definition
+type MetaobjectStorefrontAccess string
+
+const (
+	MetaobjectStorefrontAccessNone       MetaobjectStorefrontAccess = "NONE"
+	MetaobjectStorefrontAccessPublicRead MetaobjectStorefrontAccess = "PUBLIC_READ"
+)
+
+// MetaobjectStatus is the publishing status of a metaobject
+type MetaobjectStatus string
+
+const (
+	MetaobjectStatusActive MetaobjectStatus = "ACTIVE"
+	MetaobjectStatusDraft  MetaobjectStatus = "DRAFT"
+)
+
+// MetaobjectDefinition represents a custom content type
+type MetaobjectDefinition struct {
+	Id               GID                         `json:"id"`
+	Type             string                      `json:"type"`
+	Name             string                      `json:"name,omitempty"`
+	Description      string                      `json:"description,omitempty"`
+	DisplayNameKey   string                      `json:"displayNameKey,omitempty"`
+	Access           *MetaobjectAccess           `json:"access,omitempty"`
+	Capabilities     *MetaobjectCapabilities     `json:"capabilities,omitempty"`
+	FieldDefinitions []MetaobjectFieldDefinition `json:"fieldDefinitions,omitempty"`
+	MetaobjectsCount int                         `json:"metaobjectsCount,omitempty"`
+}
+
+// MetaobjectAccess represents the access settings of a metaobject definition
+type MetaobjectAccess struct {
+	Admin      MetaobjectAdminAccess      `json:"admin,omitempty"`
+	Storefront MetaobjectStorefrontAccess `json:"storefront,omitempty"`
+}
+
+// MetaobjectCapabilities represents the capabilities enabled on a metaobject
+// definition
+type MetaobjectCapabilities struct {
+	Publishable  *MetaobjectCapability `json:"publishable,omitempty"`
+	Translatable *MetaobjectCapability `json:"translatable,omitempty"`
+}
+
+// MetaobjectCapability represents whether a capability is enabled
+type MetaobjectCapability struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MetaobjectFieldDefinition represents a field of a metaobject definition
+type MetaobjectFieldDefinition struct {
+	Key         string                          `json:"key"`
+	Name        string                          `json:"name,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Required    bool                            `json:"required"`
+	Type        MetafieldDefinitionType         `json:"type"`
+	Validations []MetafieldDefinitionValidation `json:"validations,omitempty"`
+}
+
+// MetaobjectDefinitionCreateInput represents the input of the
+// metaobjectDefinitionCreate mutation
+type MetaobjectDefinitionCreateInput struct {
+	Type             string                           `json:"type"`
+	Name             string                           `json:"name,omitempty"`
+	Description      string                           `json:"description,omitempty"`
+	DisplayNameKey   string                           `json:"displayNameKey,omitempty"`
+	Access           *MetaobjectAccess                `json:"access,omitempty"`
+	Capabilities     *MetaobjectCapabilities          `json:"capabilities,omitempty"`
+	FieldDefinitions []MetaobjectFieldDefinitionInput `json:"fieldDefinitions"`
+}
+
+// MetaobjectDefinitionUpdateInput represents the input of the
+// metaobjectDefinitionUpdate mutation
+type MetaobjectDefinitionUpdateInput struct {
+	Name             string                                    `json:"name,omitempty"`
+	Description      string                                    `json:"description,omitempty"`
+	DisplayNameKey   string                                    `json:"displayNameKey,omitempty"`
+	Access           *MetaobjectAccess                         `json:"access,omitempty"`
+	Capabilities     *MetaobjectCapabilities                   `json:"capabilities,omitempty"`
+	FieldDefinitions []MetaobjectFieldDefinitionOperationInput `json:"fieldDefinitions,omitempty"`
+	ResetFieldOrder  bool                                      `json:"resetFieldOrder,omitempty"`
+}
+
+// MetaobjectFieldDefinitionInput represents a field to create, or to update
+// in which case Type is ignored
+type MetaobjectFieldDefinitionInput struct {
+	Key         string                          `json:"key"`
+	Type        MetafieldType                   `json:"type,omitempty"`
+	Name        string                          `json:"name,omitempty"`
+	Description string                          `json:"description,omitempty"`
+	Required    *bool                           `json:"required,omitempty"`
+	Validations []MetafieldDefinitionValidation `json:"validations,omitempty"`
+}
+
+// MetaobjectFieldDefinitionOperationInput represents a change to the fields
+// of a definition, exactly one of the operations is set
+type MetaobjectFieldDefinitionOperationInput struct {
+	Create *MetaobjectFieldDefinitionInput       `json:"create,omitempty"`
+	Update *MetaobjectFieldDefinitionInput       `json:"update,omitempty"`
+	Delete *MetaobjectFieldDefinitionDeleteInput `json:"delete,omitempty"`
+}
+
+// MetaobjectFieldDefinitionDeleteInput represents a field to delete
+type MetaobjectFieldDefinitionDeleteInput struct {
+	Key string `json:"key"`
+}
+
+// Metaobject represents an entry of a metaobject definition
+type Metaobject struct {
+	Id           GID                         `json:"id"`
+	Type         string                      `json:"type"`
+	Handle       string                      `json:"handle"`
+	DisplayName  string                      `json:"displayName,omitempty"`
+	Fields       []MetaobjectField           `json:"fields,omitempty"`
+	Capabilities *MetaobjectCapabilitiesData `json:"capabilities,omitempty"`
+	UpdatedAt    *time.Time                  `json:"updatedAt,omitempty"`
+}
+
+// MetaobjectField represents a field value of a metaobject. Value is the
+// value as stored by Shopify, JsonValue the same value encoded as JSON.
+type MetaobjectField struct {
+	Key       string          `json:"key"`
+	Type      MetafieldType   `json:"type"`
+	Value     *string         `json:"value"`
+	JsonValue json.RawMessage `json:"jsonValue,omitempty"`
+}
+
+// MetaobjectCapabilitiesData represents the capability settings of a
+// metaobject
+type MetaobjectCapabilitiesData struct {
+	Publishable *MetaobjectPublishable `json:"publishable,omitempty"`
+}
+
+// MetaobjectPublishable represents the publishing status of a metaobject
+type MetaobjectPublishable struct {
+	Status MetaobjectStatus `json:"status"`
+}
+
+// MetaobjectFieldInput represents a field value to set, values are always
+// strings, see MetaobjectFieldsFrom to build them from a struct
+type MetaobjectFieldInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// MetaobjectCreateInput represents the input of the metaobjectCreate mutation
+type MetaobjectCreateInput struct {
+	Type         string                      `json:"type"`
+	Handle       string                      `json:"handle,omitempty"`
+	Fields       []MetaobjectFieldInput      `json:"fields,omitempty"`
+	Capabilities *MetaobjectCapabilitiesData `json:"capabilities,omitempty"`
+}
+
+// MetaobjectUpdateInput represents the input of the metaobjectUpdate mutation
+type MetaobjectUpdateInput struct {
+	Handle            string                      `json:"handle,omitempty"`
+	Fields            []MetaobjectFieldInput      `json:"fields,omitempty"`
+	Capabilities      *MetaobjectCapabilitiesData `json:"capabilities,omitempty"`
+	RedirectNewHandle bool                        `json:"redirectNewHandle,omitempty"`
+}
+
+// MetaobjectUpsertInput represents the input of the metaobjectUpsert mutation
+type MetaobjectUpsertInput struct {
+	Handle       string                      `json:"handle,omitempty"`
+	Fields       []MetaobjectFieldInput      `json:"fields,omitempty"`
+	Capabilities *MetaobjectCapabilitiesData `json:"capabilities,omitempty"`
+}
+
+// MetaobjectListOptions represents the pagination and filter options of a
+// metaobject list
+type MetaobjectListOptions struct {
+	First   int    `json:"first,omitempty"`
+	After   string `json:"after,omitempty"`
+	Query   string `json:"query,omitempty"`
+	SortKey string `json:"sortKey,omitempty"`
+	Reverse bool   `json:"reverse,omitempty"`
+}
+
+// Decode stores the field values of the metaobject in the struct pointed to
+// by v, fields are matched by key using the json tags of the struct
+func (m *Metaobject) Decode(v interface{}) error {
+	values := make(map[string]json.RawMessage, len(m.Fields))
+	for _, field := range m.Fields {
+		switch {
+		case len(field.JsonValue) > 0:
+			values[field.Key] = field.JsonValue
+		case field.Value != nil:
+			b, err := json.Marshal(*field.Value)
+			if err != nil {
+				return err
+			}
+			values[field.Key] = b
+		}
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// MetaobjectFieldsFrom builds the field values of a metaobject from a struct,
+// keys are taken from the json tags of the struct. Strings are used as is,
+// any other value is stored as its JSON encoding, e.g. numbers, booleans,
+// lists and measurements. Null values are skipped.
+func MetaobjectFieldsFrom(v interface{}) ([]MetaobjectFieldInput, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]MetaobjectFieldInput, 0, len(keys))
+	for _, key := range keys {
+		raw := bytes.TrimSpace(values[key])
+		if bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+
+		value := string(raw)
+		if len(raw) > 0 && raw[0] == '"' {
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, MetaobjectFieldInput{Key: key, Value: value})
+	}
+
+	return fields, nil
+}
+
+const metaobjectDefinitionFields = `
+    id
+    type
+    name
+    description
+    displayNameKey
+    access {
+      admin
+      storefront
+    }
+    capabilities {
+      publishable {
+        enabled
+      }
+      translatable {
+        enabled
+      }
+    }
+    fieldDefinitions {
+      key
+      name
+      description
+      required
+      type {
+        name
+        category
+      }
+      validations {
+        name
+        value
+      }
+    }
+    metaobjectsCount
+`
+
+const metaobjectFields = `
+    id
+    type
+    handle
+    displayName
+    updatedAt
+    fields {
+      key
+      type
+      value
+      jsonValue
+    }
+    capabilities {
+      publishable {
+        status
+      }
+    }
+`
+
+const metaobjectDefinitionsQuery = `query metaobjectDefinitions($after: String) {
+  metaobjectDefinitions(first: 50, after: $after) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {` + metaobjectDefinitionFields + `}
+  }
+}`
+
+const metaobjectDefinitionQuery = `query metaobjectDefinition($id: ID!) {
+  metaobjectDefinition(id: $id) {` + metaobjectDefinitionFields + `}
+}`
+
+const metaobjectDefinitionByTypeQuery = `query metaobjectDefinitionByType($type: String!) {
+  metaobjectDefinitionByType(type: $type) {` + metaobjectDefinitionFields + `}
+}`
+
+const metaobjectDefinitionCreateMutation = `mutation metaobjectDefinitionCreate($definition: MetaobjectDefinitionCreateInput!) {
+  metaobjectDefinitionCreate(definition: $definition) {
+    metaobjectDefinition {` + metaobjectDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectDefinitionUpdateMutation = `mutation metaobjectDefinitionUpdate($id: ID!, $definition: MetaobjectDefinitionUpdateInput!) {
+  metaobjectDefinitionUpdate(id: $id, definition: $definition) {
+    metaobjectDefinition {` + metaobjectDefinitionFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectDefinitionDeleteMutation = `mutation metaobjectDefinitionDelete($id: ID!) {
+  metaobjectDefinitionDelete(id: $id) {
+    deletedId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectsQuery = `query metaobjects($type: String!, $first: Int!, $after: String, $query: String, $sortKey: String, $reverse: Boolean) {
+  metaobjects(type: $type, first: $first, after: $after, query: $query, sortKey: $sortKey, reverse: $reverse) {
+    pageInfo {
+      hasNextPage
+      hasPreviousPage
+      startCursor
+      endCursor
+    }
+    nodes {` + metaobjectFields + `}
+  }
+}`
+
+const metaobjectQuery = `query metaobject($id: ID!) {
+  metaobject(id: $id) {` + metaobjectFields + `}
+}`
+
+const metaobjectByHandleQuery = `query metaobjectByHandle($handle: MetaobjectHandleInput!) {
+  metaobjectByHandle(handle: $handle) {` + metaobjectFields + `}
+}`
+
+const metaobjectCreateMutation = `mutation metaobjectCreate($metaobject: MetaobjectCreateInput!) {
+  metaobjectCreate(metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectUpdateMutation = `mutation metaobjectUpdate($id: ID!, $metaobject: MetaobjectUpdateInput!) {
+  metaobjectUpdate(id: $id, metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectUpsertMutation = `mutation metaobjectUpsert($handle: MetaobjectHandleInput!, $metaobject: MetaobjectUpsertInput!) {
+  metaobjectUpsert(handle: $handle, metaobject: $metaobject) {
+    metaobject {` + metaobjectFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const metaobjectDeleteMutation = `mutation metaobjectDelete($id: ID!) {
+  metaobjectDelete(id: $id) {
+    deletedId
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// metaobjectPageSize is the number of metaobjects requested per page when no
+// page size is given
+const metaobjectPageSize = 50
+
+// ListDefinitions lists all metaobject definitions of the shop, iterating over
+// pages
+func (s *MetaobjectServiceOp) ListDefinitions(ctx context.Context) ([]MetaobjectDefinition, error) {
+	collector := []MetaobjectDefinition{}
+	vars := map[string]interface{}{}
+
+	for {
+		resp := struct {
+			MetaobjectDefinitions struct {
+				PageInfo GraphQLPageInfo        `json:"pageInfo"`
+				Nodes    []MetaobjectDefinition `json:"nodes"`
+			} `json:"metaobjectDefinitions"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, metaobjectDefinitionsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.MetaobjectDefinitions.Nodes...)
+
+		if !resp.MetaobjectDefinitions.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.MetaobjectDefinitions.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// GetDefinition retrieves a metaobject definition by id
+func (s *MetaobjectServiceOp) GetDefinition(ctx context.Context, id GID) (*MetaobjectDefinition, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDefinitionQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectDefinition, nil
+}
+
+// GetDefinitionByType retrieves a metaobject definition by type
+func (s *MetaobjectServiceOp) GetDefinitionByType(ctx context.Context, definitionType string) (*MetaobjectDefinition, error) {
+	vars := map[string]interface{}{"type": definitionType}
+	resp := struct {
+		MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinitionByType"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDefinitionByTypeQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectDefinition, nil
+}
+
+// CreateDefinition creates a metaobject definition
+func (s *MetaobjectServiceOp) CreateDefinition(ctx context.Context, definition MetaobjectDefinitionCreateInput) (*MetaobjectDefinition, error) {
+	vars := map[string]interface{}{"definition": definition}
+	resp := struct {
+		MetaobjectDefinitionCreate struct {
+			MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
+			UserErrors           []GraphQLUserError    `json:"userErrors"`
+		} `json:"metaobjectDefinitionCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDefinitionCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetaobjectDefinitionCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectDefinitionCreate.MetaobjectDefinition, nil
+}
+
+// UpdateDefinition updates a metaobject definition, fields are created,
+// updated and deleted through the operations of the input
+func (s *MetaobjectServiceOp) UpdateDefinition(ctx context.Context, id GID, definition MetaobjectDefinitionUpdateInput) (*MetaobjectDefinition, error) {
+	vars := map[string]interface{}{"id": id, "definition": definition}
+	resp := struct {
+		MetaobjectDefinitionUpdate struct {
+			MetaobjectDefinition *MetaobjectDefinition `json:"metaobjectDefinition"`
+			UserErrors           []GraphQLUserError    `json:"userErrors"`
+		} `json:"metaobjectDefinitionUpdate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDefinitionUpdateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetaobjectDefinitionUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectDefinitionUpdate.MetaobjectDefinition, nil
+}
+
+// DeleteDefinition deletes a metaobject definition and all of its entries
+func (s *MetaobjectServiceOp) DeleteDefinition(ctx context.Context, id GID) error {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		MetaobjectDefinitionDelete struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metaobjectDefinitionDelete"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDefinitionDeleteMutation, vars, &resp)
+	if err != nil {
+		return err
+	}
+
+	return userErrorsToError(resp.MetaobjectDefinitionDelete.UserErrors)
+}
+
+// List retrieves a page of the metaobjects of a type, the returned page info
+// can be used to request the next page
+func (s *MetaobjectServiceOp) List(ctx context.Context, metaobjectType string, options *MetaobjectListOptions) ([]Metaobject, *GraphQLPageInfo, error) {
+	if options == nil {
+		options = &MetaobjectListOptions{}
+	}
+
+	first := options.First
+	if first == 0 {
+		first = metaobjectPageSize
+	}
+
+	vars := map[string]interface{}{"type": metaobjectType, "first": first}
+	if options.After != "" {
+		vars["after"] = options.After
+	}
+	if options.Query != "" {
+		vars["query"] = options.Query
+	}
+	if options.SortKey != "" {
+		vars["sortKey"] = options.SortKey
+	}
+	if options.Reverse {
+		vars["reverse"] = true
+	}
+
+	resp := struct {
+		Metaobjects struct {
+			PageInfo GraphQLPageInfo `json:"pageInfo"`
+			Nodes    []Metaobject    `json:"nodes"`
+		} `json:"metaobjects"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectsQuery, vars, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Metaobjects.Nodes, &resp.Metaobjects.PageInfo, nil
+}
+
+// ListAll lists all metaobjects of a type, iterating over pages
+func (s *MetaobjectServiceOp) ListAll(ctx context.Context, metaobjectType string) ([]Metaobject, error) {
+	collector := []Metaobject{}
+	options := &MetaobjectListOptions{}
+
+	for {
+		entities, pageInfo, err := s.List(ctx, metaobjectType, options)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if !pageInfo.HasNextPage {
+			break
+		}
+
+		options.After = pageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Get retrieves a metaobject by id
+func (s *MetaobjectServiceOp) Get(ctx context.Context, id GID) (*Metaobject, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		Metaobject *Metaobject `json:"metaobject"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Metaobject, nil
+}
+
+// GetByHandle retrieves a metaobject by type and handle
+func (s *MetaobjectServiceOp) GetByHandle(ctx context.Context, metaobjectType, handle string) (*Metaobject, error) {
+	vars := map[string]interface{}{"handle": metaobjectHandle(metaobjectType, handle)}
+	resp := struct {
+		Metaobject *Metaobject `json:"metaobjectByHandle"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectByHandleQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Metaobject, nil
+}
+
+// Create creates a metaobject
+func (s *MetaobjectServiceOp) Create(ctx context.Context, metaobject MetaobjectCreateInput) (*Metaobject, error) {
+	vars := map[string]interface{}{"metaobject": metaobject}
+	resp := struct {
+		MetaobjectCreate struct {
+			Metaobject *Metaobject        `json:"metaobject"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metaobjectCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetaobjectCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectCreate.Metaobject, nil
+}
+
+// Update updates a metaobject, only the given fields are changed
+func (s *MetaobjectServiceOp) Update(ctx context.Context, id GID, metaobject MetaobjectUpdateInput) (*Metaobject, error) {
+	vars := map[string]interface{}{"id": id, "metaobject": metaobject}
+	resp := struct {
+		MetaobjectUpdate struct {
+			Metaobject *Metaobject        `json:"metaobject"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metaobjectUpdate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectUpdateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetaobjectUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectUpdate.Metaobject, nil
+}
+
+// Upsert creates the metaobject with the given type and handle, or updates
+// it if it already exists
+func (s *MetaobjectServiceOp) Upsert(ctx context.Context, metaobjectType, handle string, metaobject MetaobjectUpsertInput) (*Metaobject, error) {
+	vars := map[string]interface{}{"handle": metaobjectHandle(metaobjectType, handle), "metaobject": metaobject}
+	resp := struct {
+		MetaobjectUpsert struct {
+			Metaobject *Metaobject        `json:"metaobject"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metaobjectUpsert"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectUpsertMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.MetaobjectUpsert.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.MetaobjectUpsert.Metaobject, nil
+}
+
+// Delete deletes a metaobject
+func (s *MetaobjectServiceOp) Delete(ctx context.Context, id GID) error {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		MetaobjectDelete struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"metaobjectDelete"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, metaobjectDeleteMutation, vars, &resp)
+	if err != nil {
+		return err
+	}
+
+	return userErrorsToError(resp.MetaobjectDelete.UserErrors)
+}
+
+// metaobjectHandle builds the MetaobjectHandleInput of a type and handle
+func metaobjectHandle(metaobjectType, handle string) map[string]string {
+	return map[string]string{"type": metaobjectType, "handle": handle}
+}
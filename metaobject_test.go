@@ -0,0 +1,508 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const testMetaobjectResponse = `{
+	"id":"gid://shopify/Metaobject/1",
+	"type":"designer",
+	"handle":"jane-doe",
+	"displayName":"Jane Doe",
+	"updatedAt":"2024-01-02T09:00:00-05:00",
+	"fields":[
+		{"key":"name","type":"single_line_text_field","value":"Jane Doe","jsonValue":"Jane Doe"},
+		{"key":"years_active","type":"number_integer","value":"12","jsonValue":12},
+		{"key":"featured","type":"boolean","value":"true","jsonValue":true},
+		{"key":"styles","type":"list.single_line_text_field","value":"[\"modern\",\"rustic\"]","jsonValue":["modern","rustic"]},
+		{"key":"rate","type":"number_decimal","value":"9.5","jsonValue":"9.5"},
+		{"key":"bio","type":"multi_line_text_field","value":null,"jsonValue":null}
+	],
+	"capabilities":{"publishable":{"status":"ACTIVE"}}
+}`
+
+type testDesigner struct {
+	Name        string          `json:"name"`
+	YearsActive int             `json:"years_active"`
+	Featured    bool            `json:"featured"`
+	Styles      []string        `json:"styles"`
+	Rate        decimal.Decimal `json:"rate"`
+	Bio         *string         `json:"bio"`
+}
+
+func TestMetaobjectListDefinitions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"metaobjectDefinitions":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"id":"gid://shopify/MetaobjectDefinition/1","type":"designer"}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectDefinitions":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{"id":"gid://shopify/MetaobjectDefinition/2","type":"lookbook"}]
+			}}}`), nil
+		},
+	)
+
+	definitions, err := client.Metaobject.ListDefinitions(context.Background())
+	if err != nil {
+		t.Fatalf("Metaobject.ListDefinitions returned error: %v", err)
+	}
+
+	expected := []MetaobjectDefinition{
+		{Id: NewGID(GIDResourceMetaobjectDefinition, 1), Type: "designer"},
+		{Id: NewGID(GIDResourceMetaobjectDefinition, 2), Type: "lookbook"},
+	}
+	if !reflect.DeepEqual(definitions, expected) {
+		t.Errorf("Metaobject.ListDefinitions returned %+v, expected %+v", definitions, expected)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("Metaobject.ListDefinitions requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+}
+
+func TestMetaobjectGetDefinitionByType(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var definitionType string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			definitionType = string(vars["type"])
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectDefinitionByType":{
+				"id":"gid://shopify/MetaobjectDefinition/1",
+				"type":"designer",
+				"name":"Designer",
+				"displayNameKey":"name",
+				"access":{"admin":"MERCHANT_READ_WRITE","storefront":"PUBLIC_READ"},
+				"capabilities":{"publishable":{"enabled":true},"translatable":{"enabled":false}},
+				"fieldDefinitions":[{
+					"key":"years_active",
+					"name":"Years active",
+					"required":true,
+					"type":{"name":"number_integer","category":"NUMBER"},
+					"validations":[{"name":"min","value":"0"}]
+				}],
+				"metaobjectsCount":3
+			}}}`), nil
+		},
+	)
+
+	definition, err := client.Metaobject.GetDefinitionByType(context.Background(), "designer")
+	if err != nil {
+		t.Fatalf("Metaobject.GetDefinitionByType returned error: %v", err)
+	}
+
+	if definitionType != `"designer"` {
+		t.Errorf("Metaobject.GetDefinitionByType sent type %s", definitionType)
+	}
+
+	expected := &MetaobjectDefinition{
+		Id:             NewGID(GIDResourceMetaobjectDefinition, 1),
+		Type:           "designer",
+		Name:           "Designer",
+		DisplayNameKey: "name",
+		Access:         &MetaobjectAccess{Admin: MetaobjectAdminAccessMerchantReadWrite, Storefront: MetaobjectStorefrontAccessPublicRead},
+		Capabilities: &MetaobjectCapabilities{
+			Publishable:  &MetaobjectCapability{Enabled: true},
+			Translatable: &MetaobjectCapability{},
+		},
+		FieldDefinitions: []MetaobjectFieldDefinition{{
+			Key:         "years_active",
+			Name:        "Years active",
+			Required:    true,
+			Type:        MetafieldDefinitionType{Name: MetafieldTypeNumberInteger, Category: "NUMBER"},
+			Validations: []MetafieldDefinitionValidation{{Name: "min", Value: "0"}},
+		}},
+		MetaobjectsCount: 3,
+	}
+	if !reflect.DeepEqual(definition, expected) {
+		t.Errorf("Metaobject.GetDefinitionByType returned %+v, expected %+v", definition, expected)
+	}
+}
+
+func TestMetaobjectCreateDefinition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["definition"])
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectDefinitionCreate":{
+				"metaobjectDefinition":{"id":"gid://shopify/MetaobjectDefinition/1","type":"designer"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	required := true
+	definition, err := client.Metaobject.CreateDefinition(context.Background(), MetaobjectDefinitionCreateInput{
+		Type:   "designer",
+		Name:   "Designer",
+		Access: &MetaobjectAccess{Storefront: MetaobjectStorefrontAccessPublicRead},
+		FieldDefinitions: []MetaobjectFieldDefinitionInput{
+			{Key: "name", Type: MetafieldTypeSingleLineTextField, Required: &required},
+			{Key: "years_active", Type: MetafieldTypeNumberInteger, Validations: []MetafieldDefinitionValidation{{Name: "min", Value: "0"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Metaobject.CreateDefinition returned error: %v", err)
+	}
+
+	expectedInput := `{"type":"designer","name":"Designer","access":{"storefront":"PUBLIC_READ"},"fieldDefinitions":[` +
+		`{"key":"name","type":"single_line_text_field","required":true},` +
+		`{"key":"years_active","type":"number_integer","validations":[{"name":"min","value":"0"}]}]}`
+	if input != expectedInput {
+		t.Errorf("Metaobject.CreateDefinition sent %s, expected %s", input, expectedInput)
+	}
+
+	if definition.Id.Id != 1 {
+		t.Errorf("Metaobject.CreateDefinition returned %+v", definition)
+	}
+}
+
+func TestMetaobjectUpdateDefinition(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["definition"])
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectDefinitionUpdate":{
+				"metaobjectDefinition":{"id":"gid://shopify/MetaobjectDefinition/1","type":"designer"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	_, err := client.Metaobject.UpdateDefinition(context.Background(), NewGID(GIDResourceMetaobjectDefinition, 1), MetaobjectDefinitionUpdateInput{
+		FieldDefinitions: []MetaobjectFieldDefinitionOperationInput{
+			{Create: &MetaobjectFieldDefinitionInput{Key: "bio", Type: MetafieldTypeMultiLineTextField}},
+			{Delete: &MetaobjectFieldDefinitionDeleteInput{Key: "rate"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Metaobject.UpdateDefinition returned error: %v", err)
+	}
+
+	expectedInput := `{"fieldDefinitions":[{"create":{"key":"bio","type":"multi_line_text_field"}},{"delete":{"key":"rate"}}]}`
+	if input != expectedInput {
+		t.Errorf("Metaobject.UpdateDefinition sent %s, expected %s", input, expectedInput)
+	}
+}
+
+func TestMetaobjectDeleteDefinitionUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"metaobjectDefinitionDelete":{
+			"deletedId":null,
+			"userErrors":[{"field":["id"],"message":"Record not found"}]
+		}}}`),
+	)
+
+	err := client.Metaobject.DeleteDefinition(context.Background(), NewGID(GIDResourceMetaobjectDefinition, 1))
+
+	expectedErr := "id: Record not found"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Metaobject.DeleteDefinition returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestMetaobjectList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, v := graphQLRequestBody(req)
+			vars = map[string]string{}
+			for key, value := range v {
+				vars[key] = string(value)
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjects":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"def"},
+				"nodes":[`+testMetaobjectResponse+`]
+			}}}`), nil
+		},
+	)
+
+	metaobjects, pageInfo, err := client.Metaobject.List(context.Background(), "designer", &MetaobjectListOptions{First: 10, After: "abc", Query: "display_name:Jane*"})
+	if err != nil {
+		t.Fatalf("Metaobject.List returned error: %v", err)
+	}
+
+	expectedVars := map[string]string{"type": `"designer"`, "first": "10", "after": `"abc"`, "query": `"display_name:Jane*"`}
+	if !reflect.DeepEqual(vars, expectedVars) {
+		t.Errorf("Metaobject.List sent %+v, expected %+v", vars, expectedVars)
+	}
+
+	if len(metaobjects) != 1 || metaobjects[0].Handle != "jane-doe" || len(metaobjects[0].Fields) != 6 {
+		t.Errorf("Metaobject.List returned %+v", metaobjects)
+	}
+
+	if pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor != "def" {
+		t.Errorf("Metaobject.List returned page info %+v", pageInfo)
+	}
+}
+
+func TestMetaobjectListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var firsts []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			firsts = append(firsts, string(vars["first"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"metaobjects":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"id":"gid://shopify/Metaobject/1","type":"designer","handle":"a"}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjects":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{"id":"gid://shopify/Metaobject/2","type":"designer","handle":"b"}]
+			}}}`), nil
+		},
+	)
+
+	metaobjects, err := client.Metaobject.ListAll(context.Background(), "designer")
+	if err != nil {
+		t.Fatalf("Metaobject.ListAll returned error: %v", err)
+	}
+
+	expected := []Metaobject{
+		{Id: NewGID(GIDResourceMetaobject, 1), Type: "designer", Handle: "a"},
+		{Id: NewGID(GIDResourceMetaobject, 2), Type: "designer", Handle: "b"},
+	}
+	if !reflect.DeepEqual(metaobjects, expected) {
+		t.Errorf("Metaobject.ListAll returned %+v, expected %+v", metaobjects, expected)
+	}
+
+	expectedFirsts := []string{"50", "50"}
+	if !reflect.DeepEqual(firsts, expectedFirsts) {
+		t.Errorf("Metaobject.ListAll requested page sizes %+v, expected %+v", firsts, expectedFirsts)
+	}
+}
+
+func TestMetaobjectGetByHandleDecode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var handle string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			handle = string(vars["handle"])
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectByHandle":`+testMetaobjectResponse+`}}`), nil
+		},
+	)
+
+	metaobject, err := client.Metaobject.GetByHandle(context.Background(), "designer", "jane-doe")
+	if err != nil {
+		t.Fatalf("Metaobject.GetByHandle returned error: %v", err)
+	}
+
+	expectedHandle := `{"handle":"jane-doe","type":"designer"}`
+	if handle != expectedHandle {
+		t.Errorf("Metaobject.GetByHandle sent handle %s, expected %s", handle, expectedHandle)
+	}
+
+	if metaobject.Capabilities == nil || metaobject.Capabilities.Publishable.Status != MetaobjectStatusActive {
+		t.Errorf("Metaobject.Capabilities returned %+v", metaobject.Capabilities)
+	}
+
+	var designer testDesigner
+	if err := metaobject.Decode(&designer); err != nil {
+		t.Fatalf("Metaobject.Decode returned error: %v", err)
+	}
+
+	expected := testDesigner{
+		Name:        "Jane Doe",
+		YearsActive: 12,
+		Featured:    true,
+		Styles:      []string{"modern", "rustic"},
+		Rate:        decimal.RequireFromString("9.5"),
+	}
+	if !reflect.DeepEqual(designer, expected) {
+		t.Errorf("Metaobject.Decode returned %+v, expected %+v", designer, expected)
+	}
+}
+
+func TestMetaobjectDecodeWithoutJsonValue(t *testing.T) {
+	value := "Jane Doe"
+	metaobject := Metaobject{Fields: []MetaobjectField{{Key: "name", Type: MetafieldTypeSingleLineTextField, Value: &value}}}
+
+	var designer testDesigner
+	if err := metaobject.Decode(&designer); err != nil {
+		t.Fatalf("Metaobject.Decode returned error: %v", err)
+	}
+
+	if designer.Name != value {
+		t.Errorf("Metaobject.Decode returned %+v", designer)
+	}
+}
+
+func TestMetaobjectFieldsFrom(t *testing.T) {
+	fields, err := MetaobjectFieldsFrom(testDesigner{
+		Name:        "Jane Doe",
+		YearsActive: 12,
+		Featured:    true,
+		Styles:      []string{"modern", "rustic"},
+		Rate:        decimal.RequireFromString("9.5"),
+	})
+	if err != nil {
+		t.Fatalf("MetaobjectFieldsFrom returned error: %v", err)
+	}
+
+	expected := []MetaobjectFieldInput{
+		{Key: "featured", Value: "true"},
+		{Key: "name", Value: "Jane Doe"},
+		{Key: "rate", Value: "9.5"},
+		{Key: "styles", Value: `["modern","rustic"]`},
+		{Key: "years_active", Value: "12"},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("MetaobjectFieldsFrom returned %+v, expected %+v", fields, expected)
+	}
+}
+
+func TestMetaobjectUpsert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var handle, input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			handle, input = string(vars["handle"]), string(vars["metaobject"])
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectUpsert":{"metaobject":`+testMetaobjectResponse+`,"userErrors":[]}}}`), nil
+		},
+	)
+
+	metaobject, err := client.Metaobject.Upsert(context.Background(), "designer", "jane-doe", MetaobjectUpsertInput{
+		Fields:       []MetaobjectFieldInput{{Key: "name", Value: "Jane Doe"}},
+		Capabilities: &MetaobjectCapabilitiesData{Publishable: &MetaobjectPublishable{Status: MetaobjectStatusActive}},
+	})
+	if err != nil {
+		t.Fatalf("Metaobject.Upsert returned error: %v", err)
+	}
+
+	expectedHandle := `{"handle":"jane-doe","type":"designer"}`
+	if handle != expectedHandle {
+		t.Errorf("Metaobject.Upsert sent handle %s, expected %s", handle, expectedHandle)
+	}
+
+	expectedInput := `{"fields":[{"key":"name","value":"Jane Doe"}],"capabilities":{"publishable":{"status":"ACTIVE"}}}`
+	if input != expectedInput {
+		t.Errorf("Metaobject.Upsert sent %s, expected %s", input, expectedInput)
+	}
+
+	if metaobject.Id.Id != 1 {
+		t.Errorf("Metaobject.Upsert returned %+v", metaobject)
+	}
+}
+
+func TestMetaobjectCreateUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"metaobjectCreate":{
+			"metaobject":null,
+			"userErrors":[{"field":["metaobject","fields","0"],"message":"Value must be an integer"}]
+		}}}`),
+	)
+
+	metaobject, err := client.Metaobject.Create(context.Background(), MetaobjectCreateInput{
+		Type:   "designer",
+		Fields: []MetaobjectFieldInput{{Key: "years_active", Value: "twelve"}},
+	})
+	if metaobject != nil {
+		t.Errorf("Metaobject.Create returned %+v, expected nil", metaobject)
+	}
+
+	expectedErr := "metaobject.fields.0: Value must be an integer"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Metaobject.Create returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestMetaobjectUpdateAndDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var operations []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, _ := graphQLRequestBody(req)
+			operations = append(operations, GraphQLOperationName(q))
+			if GraphQLOperationName(q) == "metaobjectDelete" {
+				return httpmock.NewStringResponse(200, `{"data":{"metaobjectDelete":{"deletedId":"gid://shopify/Metaobject/1","userErrors":[]}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"metaobjectUpdate":{"metaobject":`+testMetaobjectResponse+`,"userErrors":[]}}}`), nil
+		},
+	)
+
+	id := NewGID(GIDResourceMetaobject, 1)
+	if _, err := client.Metaobject.Update(context.Background(), id, MetaobjectUpdateInput{Handle: "jane-doe", RedirectNewHandle: true}); err != nil {
+		t.Errorf("Metaobject.Update returned error: %v", err)
+	}
+
+	if err := client.Metaobject.Delete(context.Background(), id); err != nil {
+		t.Errorf("Metaobject.Delete returned error: %v", err)
+	}
+
+	expected := []string{"metaobjectUpdate", "metaobjectDelete"}
+	if !reflect.DeepEqual(operations, expected) {
+		t.Errorf("Metaobject sent operations %+v, expected %+v", operations, expected)
+	}
+}
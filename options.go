@@ -40,3 +40,11 @@ func WithHTTPClient(client *http.Client) Option {
 		c.Client = client
 	}
 }
+
+// WithGraphQLHook sets a hook called after every graphql request with the
+// operation name and cost of the request, e.g. to record metrics
+func WithGraphQLHook(hook GraphQLHook) Option {
+	return func(c *Client) {
+		c.graphQLHook = hook
+	}
+}
@@ -579,27 +579,28 @@ type TaxLine struct {
 }
 
 type Transaction struct {
-	Id             uint64                 `json:"id,omitempty"`
-	OrderId        uint64                 `json:"order_id,omitempty"`
-	Amount         *decimal.Decimal       `json:"amount,omitempty"`
-	Kind           string                 `json:"kind,omitempty"`
-	Gateway        string                 `json:"gateway,omitempty"`
-	Status         string                 `json:"status,omitempty"`
-	Message        string                 `json:"message,omitempty"`
-	CreatedAt      *time.Time             `json:"created_at,omitempty"`
-	Test           bool                   `json:"test,omitempty"`
-	Authorization  string                 `json:"authorization,omitempty"`
-	PaymentId      string                 `json:"payment_id,omitempty"`
-	Receipt        map[string]interface{} `json:"receipt,omitempty"`
-	Currency       string                 `json:"currency,omitempty"`
-	LocationId     *int64                 `json:"location_id,omitempty"`
-	UserId         *int64                 `json:"user_id,omitempty"`
-	ParentId       *int64                 `json:"parent_id,omitempty"`
-	DeviceId       *int64                 `json:"device_id,omitempty"`
-	ErrorCode      string                 `json:"error_code,omitempty"`
-	SourceName     string                 `json:"source_name,omitempty"`
-	Source         string                 `json:"source,omitempty"`
-	PaymentDetails *PaymentDetails        `json:"payment_details,omitempty"`
+	Id                uint64                 `json:"id,omitempty"`
+	OrderId           uint64                 `json:"order_id,omitempty"`
+	Amount            *decimal.Decimal       `json:"amount,omitempty"`
+	MaximumRefundable *decimal.Decimal       `json:"maximum_refundable,omitempty"`
+	Kind              string                 `json:"kind,omitempty"`
+	Gateway           string                 `json:"gateway,omitempty"`
+	Status            string                 `json:"status,omitempty"`
+	Message           string                 `json:"message,omitempty"`
+	CreatedAt         *time.Time             `json:"created_at,omitempty"`
+	Test              bool                   `json:"test,omitempty"`
+	Authorization     string                 `json:"authorization,omitempty"`
+	PaymentId         string                 `json:"payment_id,omitempty"`
+	Receipt           map[string]interface{} `json:"receipt,omitempty"`
+	Currency          string                 `json:"currency,omitempty"`
+	LocationId        *int64                 `json:"location_id,omitempty"`
+	UserId            *int64                 `json:"user_id,omitempty"`
+	ParentId          *int64                 `json:"parent_id,omitempty"`
+	DeviceId          *int64                 `json:"device_id,omitempty"`
+	ErrorCode         string                 `json:"error_code,omitempty"`
+	SourceName        string                 `json:"source_name,omitempty"`
+	Source            string                 `json:"source,omitempty"`
+	PaymentDetails    *PaymentDetails        `json:"payment_details,omitempty"`
 }
 
 type ClientDetails struct {
@@ -615,13 +616,19 @@ type Refund struct {
 	Id                  uint64               `json:"id,omitempty"`
 	OrderId             uint64               `json:"order_id,omitempty"`
 	CreatedAt           *time.Time           `json:"created_at,omitempty"`
+	ProcessedAt         *time.Time           `json:"processed_at,omitempty"`
 	Note                string               `json:"note,omitempty"`
 	Restock             bool                 `json:"restock,omitempty"`
 	UserId              uint64               `json:"user_id,omitempty"`
+	Currency            string               `json:"currency,omitempty"`
+	Notify              bool                 `json:"notify,omitempty"`
+	DiscrepancyReason   string               `json:"discrepancy_reason,omitempty"`
+	Shipping            *RefundShipping      `json:"shipping,omitempty"`
 	RefundLineItems     []RefundLineItem     `json:"refund_line_items,omitempty"`
 	Transactions        []Transaction        `json:"transactions,omitempty"`
 	OrderAdjustments    []OrderAdjustment    `json:"order_adjustments,omitempty"`
 	RefundShippingLines []RefundShippingLine `json:"refund_shipping_lines,omitempty"`
+	AdminGraphqlApiId   string               `json:"admin_graphql_api_id,omitempty"`
 }
 
 type RefundShippingLine struct {
@@ -650,14 +657,19 @@ const (
 )
 
 type RefundLineItem struct {
-	Id          uint64           `json:"id,omitempty"`
-	Quantity    int              `json:"quantity,omitempty"`
-	LineItemId  uint64           `json:"line_item_id,omitempty"`
-	LineItem    *LineItem        `json:"line_item,omitempty"`
-	Subtotal    *decimal.Decimal `json:"subtotal,omitempty"`
-	TotalTax    *decimal.Decimal `json:"total_tax,omitempty"`
-	SubTotalSet *AmountSet       `json:"subtotal_set,omitempty"`
-	TotalTaxSet *AmountSet       `json:"total_tax_set,omitempty"`
+	Id                   uint64            `json:"id,omitempty"`
+	Quantity             int               `json:"quantity,omitempty"`
+	LineItemId           uint64            `json:"line_item_id,omitempty"`
+	LineItem             *LineItem         `json:"line_item,omitempty"`
+	RestockType          RefundRestockType `json:"restock_type,omitempty"`
+	LocationId           uint64            `json:"location_id,omitempty"`
+	Price                *decimal.Decimal  `json:"price,omitempty"`
+	Subtotal             *decimal.Decimal  `json:"subtotal,omitempty"`
+	TotalTax             *decimal.Decimal  `json:"total_tax,omitempty"`
+	DiscountedPrice      *decimal.Decimal  `json:"discounted_price,omitempty"`
+	DiscountedTotalPrice *decimal.Decimal  `json:"discounted_total_price,omitempty"`
+	SubTotalSet          *AmountSet        `json:"subtotal_set,omitempty"`
+	TotalTaxSet          *AmountSet        `json:"total_tax_set,omitempty"`
 }
 
 // List orders
@@ -0,0 +1,397 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+)
+
+// OrderEditService is an interface for editing the line items of an order
+// through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/fulfillment/order-management-apps/order-editing
+type OrderEditService interface {
+	Begin(context.Context, uint64) (*CalculatedOrder, error)
+	AddVariant(context.Context, GID, OrderEditAddVariantInput) (*CalculatedLineItem, error)
+	SetQuantity(context.Context, GID, OrderEditSetQuantityInput) (*CalculatedLineItem, error)
+	AddLineItemDiscount(context.Context, GID, OrderEditLineItemDiscountInput) (*CalculatedLineItem, error)
+	Commit(context.Context, GID, OrderEditCommitOptions) (GID, error)
+	Edit(context.Context, uint64, []OrderEditChange, OrderEditCommitOptions) (GID, error)
+}
+
+// OrderEditServiceOp handles communication with the order editing related
+// methods of the Shopify API.
+type OrderEditServiceOp struct {
+	client *Client
+}
+
+// CalculatedOrder represents an order with the staged changes of an order
+// edit applied
+type CalculatedOrder struct {
+	Id                        GID                  `json:"id"`
+	OriginalOrderId           GID                  `json:"-"`
+	SubtotalLineItemsQuantity int                  `json:"subtotalLineItemsQuantity"`
+	SubtotalPriceSet          *MoneyBag            `json:"subtotalPriceSet,omitempty"`
+	TotalPriceSet             *MoneyBag            `json:"totalPriceSet,omitempty"`
+	TotalOutstandingSet       *MoneyBag            `json:"totalOutstandingSet,omitempty"`
+	LineItems                 []CalculatedLineItem `json:"-"`
+	AddedLineItems            []CalculatedLineItem `json:"-"`
+}
+
+// CalculatedLineItem represents a line item of a calculated order
+type CalculatedLineItem struct {
+	Id                     GID       `json:"id"`
+	Title                  string    `json:"title,omitempty"`
+	Sku                    string    `json:"sku,omitempty"`
+	Quantity               int       `json:"quantity"`
+	EditableQuantity       int       `json:"editableQuantity"`
+	Restockable            bool      `json:"restockable"`
+	VariantId              GID       `json:"-"`
+	OriginalUnitPriceSet   *MoneyBag `json:"originalUnitPriceSet,omitempty"`
+	DiscountedUnitPriceSet *MoneyBag `json:"discountedUnitPriceSet,omitempty"`
+}
+
+// OrderEditAddVariantInput represents a variant to add to an order
+type OrderEditAddVariantInput struct {
+	VariantId       uint64
+	Quantity        int
+	LocationId      uint64
+	AllowDuplicates bool
+
+	// Discount is applied to the added line item if set
+	Discount *OrderEditAppliedDiscountInput
+}
+
+// OrderEditSetQuantityInput represents a quantity change of a calculated
+// line item. Line items of the order can be referenced with
+// NewGID(GIDResourceCalculatedLineItem, lineItem.Id).
+type OrderEditSetQuantityInput struct {
+	LineItemId GID
+	Quantity   int
+	Restock    bool
+}
+
+// OrderEditLineItemDiscountInput represents a discount applied to a
+// calculated line item
+type OrderEditLineItemDiscountInput struct {
+	LineItemId GID
+	Discount   OrderEditAppliedDiscountInput
+}
+
+// OrderEditAppliedDiscountInput represents a fixed or percentage discount,
+// only one of FixedValue and PercentValue should be set
+type OrderEditAppliedDiscountInput struct {
+	Description  string   `json:"description,omitempty"`
+	FixedValue   *MoneyV2 `json:"fixedValue,omitempty"`
+	PercentValue *float64 `json:"percentValue,omitempty"`
+}
+
+// OrderEditCommitOptions represents the options of committing an order edit
+type OrderEditCommitOptions struct {
+	NotifyCustomer bool
+	StaffNote      string
+}
+
+// OrderEditChange represents a single staged change of an order edit, only
+// one of the fields should be set
+type OrderEditChange struct {
+	AddVariant          *OrderEditAddVariantInput
+	SetQuantity         *OrderEditSetQuantityInput
+	AddLineItemDiscount *OrderEditLineItemDiscountInput
+}
+
+// calculatedOrder is used to unmarshal a calculated order with its
+// connections
+type calculatedOrder struct {
+	CalculatedOrder
+	OriginalOrder struct {
+		Id GID `json:"id"`
+	} `json:"originalOrder"`
+	LineItems struct {
+		Nodes []calculatedLineItem `json:"nodes"`
+	} `json:"lineItems"`
+	AddedLineItems struct {
+		Nodes []calculatedLineItem `json:"nodes"`
+	} `json:"addedLineItems"`
+}
+
+func (o *calculatedOrder) toCalculatedOrder() *CalculatedOrder {
+	order := o.CalculatedOrder
+	order.OriginalOrderId = o.OriginalOrder.Id
+	order.LineItems = toCalculatedLineItems(o.LineItems.Nodes)
+	order.AddedLineItems = toCalculatedLineItems(o.AddedLineItems.Nodes)
+	return &order
+}
+
+// calculatedLineItem is used to unmarshal a calculated line item with its
+// variant
+type calculatedLineItem struct {
+	CalculatedLineItem
+	Variant *struct {
+		Id GID `json:"id"`
+	} `json:"variant"`
+}
+
+func (l *calculatedLineItem) toCalculatedLineItem() *CalculatedLineItem {
+	if l == nil {
+		return nil
+	}
+	lineItem := l.CalculatedLineItem
+	if l.Variant != nil {
+		lineItem.VariantId = l.Variant.Id
+	}
+	return &lineItem
+}
+
+func toCalculatedLineItems(nodes []calculatedLineItem) []CalculatedLineItem {
+	lineItems := make([]CalculatedLineItem, 0, len(nodes))
+	for i := range nodes {
+		lineItems = append(lineItems, *nodes[i].toCalculatedLineItem())
+	}
+	return lineItems
+}
+
+// calculatedLineItemPayload represents the payload of the line item mutations
+type calculatedLineItemPayload struct {
+	CalculatedLineItem *calculatedLineItem `json:"calculatedLineItem"`
+	UserErrors         []GraphQLUserError  `json:"userErrors"`
+}
+
+const moneyBagFields = `{
+  shopMoney { amount currencyCode }
+  presentmentMoney { amount currencyCode }
+}`
+
+const calculatedLineItemFields = `
+  id
+  title
+  sku
+  quantity
+  editableQuantity
+  restockable
+  variant { id }
+  originalUnitPriceSet ` + moneyBagFields + `
+  discountedUnitPriceSet ` + moneyBagFields + `
+`
+
+const orderEditBeginMutation = `mutation orderEditBegin($id: ID!) {
+  orderEditBegin(id: $id) {
+    calculatedOrder {
+      id
+      originalOrder { id }
+      subtotalLineItemsQuantity
+      subtotalPriceSet ` + moneyBagFields + `
+      totalPriceSet ` + moneyBagFields + `
+      totalOutstandingSet ` + moneyBagFields + `
+      lineItems(first: 250) {
+        nodes {` + calculatedLineItemFields + `}
+      }
+      addedLineItems(first: 250) {
+        nodes {` + calculatedLineItemFields + `}
+      }
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const orderEditAddVariantMutation = `mutation orderEditAddVariant($id: ID!, $variantId: ID!, $quantity: Int!, $locationId: ID, $allowDuplicates: Boolean) {
+  orderEditAddVariant(id: $id, variantId: $variantId, quantity: $quantity, locationId: $locationId, allowDuplicates: $allowDuplicates) {
+    calculatedLineItem {` + calculatedLineItemFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const orderEditSetQuantityMutation = `mutation orderEditSetQuantity($id: ID!, $lineItemId: ID!, $quantity: Int!, $restock: Boolean) {
+  orderEditSetQuantity(id: $id, lineItemId: $lineItemId, quantity: $quantity, restock: $restock) {
+    calculatedLineItem {` + calculatedLineItemFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const orderEditAddLineItemDiscountMutation = `mutation orderEditAddLineItemDiscount($id: ID!, $lineItemId: ID!, $discount: OrderEditAppliedDiscountInput!) {
+  orderEditAddLineItemDiscount(id: $id, lineItemId: $lineItemId, discount: $discount) {
+    calculatedLineItem {` + calculatedLineItemFields + `}
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const orderEditCommitMutation = `mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+  orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote) {
+    order { id }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// Begin starts editing an order, returning the calculated order that changes
+// are staged on
+func (s *OrderEditServiceOp) Begin(ctx context.Context, orderId uint64) (*CalculatedOrder, error) {
+	vars := map[string]interface{}{"id": NewGID(GIDResourceOrder, orderId)}
+	resp := struct {
+		OrderEditBegin struct {
+			CalculatedOrder *calculatedOrder   `json:"calculatedOrder"`
+			UserErrors      []GraphQLUserError `json:"userErrors"`
+		} `json:"orderEditBegin"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, orderEditBeginMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.OrderEditBegin.UserErrors); err != nil {
+		return nil, err
+	}
+
+	if resp.OrderEditBegin.CalculatedOrder == nil {
+		return nil, errors.New("order edit was not started, no calculated order returned")
+	}
+
+	return resp.OrderEditBegin.CalculatedOrder.toCalculatedOrder(), nil
+}
+
+// AddVariant stages adding a variant to the calculated order, applying the
+// input's discount to the added line item if set
+func (s *OrderEditServiceOp) AddVariant(ctx context.Context, calculatedOrderId GID, input OrderEditAddVariantInput) (*CalculatedLineItem, error) {
+	vars := map[string]interface{}{
+		"id":              calculatedOrderId,
+		"variantId":       NewGID(GIDResourceProductVariant, input.VariantId),
+		"quantity":        input.Quantity,
+		"allowDuplicates": input.AllowDuplicates,
+	}
+	if input.LocationId != 0 {
+		vars["locationId"] = NewGID(GIDResourceLocation, input.LocationId)
+	}
+	resp := struct {
+		OrderEditAddVariant calculatedLineItemPayload `json:"orderEditAddVariant"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, orderEditAddVariantMutation, vars, &resp)
+	lineItem, err := payloadCalculatedLineItem(resp.OrderEditAddVariant, err)
+	if err != nil || lineItem == nil || input.Discount == nil {
+		return lineItem, err
+	}
+
+	return s.AddLineItemDiscount(ctx, calculatedOrderId, OrderEditLineItemDiscountInput{
+		LineItemId: lineItem.Id,
+		Discount:   *input.Discount,
+	})
+}
+
+// SetQuantity stages a quantity change of a line item, a quantity of 0
+// removes the line item
+func (s *OrderEditServiceOp) SetQuantity(ctx context.Context, calculatedOrderId GID, input OrderEditSetQuantityInput) (*CalculatedLineItem, error) {
+	vars := map[string]interface{}{
+		"id":         calculatedOrderId,
+		"lineItemId": input.LineItemId,
+		"quantity":   input.Quantity,
+		"restock":    input.Restock,
+	}
+	resp := struct {
+		OrderEditSetQuantity calculatedLineItemPayload `json:"orderEditSetQuantity"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, orderEditSetQuantityMutation, vars, &resp)
+	return payloadCalculatedLineItem(resp.OrderEditSetQuantity, err)
+}
+
+// AddLineItemDiscount stages a discount on a line item
+func (s *OrderEditServiceOp) AddLineItemDiscount(ctx context.Context, calculatedOrderId GID, input OrderEditLineItemDiscountInput) (*CalculatedLineItem, error) {
+	vars := map[string]interface{}{
+		"id":         calculatedOrderId,
+		"lineItemId": input.LineItemId,
+		"discount":   input.Discount,
+	}
+	resp := struct {
+		OrderEditAddLineItemDiscount calculatedLineItemPayload `json:"orderEditAddLineItemDiscount"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, orderEditAddLineItemDiscountMutation, vars, &resp)
+	return payloadCalculatedLineItem(resp.OrderEditAddLineItemDiscount, err)
+}
+
+// Commit applies the staged changes of the calculated order to the order,
+// returning the id of the edited order
+func (s *OrderEditServiceOp) Commit(ctx context.Context, calculatedOrderId GID, options OrderEditCommitOptions) (GID, error) {
+	vars := map[string]interface{}{
+		"id":             calculatedOrderId,
+		"notifyCustomer": options.NotifyCustomer,
+	}
+	if options.StaffNote != "" {
+		vars["staffNote"] = options.StaffNote
+	}
+	resp := struct {
+		OrderEditCommit struct {
+			Order *struct {
+				Id GID `json:"id"`
+			} `json:"order"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"orderEditCommit"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, orderEditCommitMutation, vars, &resp)
+	if err != nil {
+		return GID{}, err
+	}
+
+	if err := userErrorsToError(resp.OrderEditCommit.UserErrors); err != nil {
+		return GID{}, err
+	}
+
+	if resp.OrderEditCommit.Order == nil {
+		return GID{}, errors.New("order edit commit returned no order")
+	}
+
+	return resp.OrderEditCommit.Order.Id, nil
+}
+
+// Edit begins an order edit, stages the given changes in order and commits
+// them. If a change fails the edit is not committed and the order is left
+// unchanged.
+func (s *OrderEditServiceOp) Edit(ctx context.Context, orderId uint64, changes []OrderEditChange, options OrderEditCommitOptions) (GID, error) {
+	calculatedOrder, err := s.Begin(ctx, orderId)
+	if err != nil {
+		return GID{}, err
+	}
+
+	for _, change := range changes {
+		switch {
+		case change.AddVariant != nil:
+			_, err = s.AddVariant(ctx, calculatedOrder.Id, *change.AddVariant)
+		case change.SetQuantity != nil:
+			_, err = s.SetQuantity(ctx, calculatedOrder.Id, *change.SetQuantity)
+		case change.AddLineItemDiscount != nil:
+			_, err = s.AddLineItemDiscount(ctx, calculatedOrder.Id, *change.AddLineItemDiscount)
+		}
+
+		if err != nil {
+			return GID{}, err
+		}
+	}
+
+	return s.Commit(ctx, calculatedOrder.Id, options)
+}
+
+func payloadCalculatedLineItem(payload calculatedLineItemPayload, err error) (*CalculatedLineItem, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return payload.CalculatedLineItem.toCalculatedLineItem(), nil
+}
@@ -0,0 +1,244 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+const testCalculatedLineItemResponse = `{
+	"id":"gid://shopify/CalculatedLineItem/e5ab1dd6-6c2d-4c80-bc5a-3efdc5ad1e8a",
+	"title":"IPod Nano - 8GB",
+	"quantity":2,
+	"editableQuantity":2,
+	"restockable":true,
+	"variant":{"id":"gid://shopify/ProductVariant/808950810"},
+	"originalUnitPriceSet":{
+		"shopMoney":{"amount":"199.0","currencyCode":"USD"},
+		"presentmentMoney":{"amount":"199.0","currencyCode":"USD"}
+	}
+}`
+
+func TestOrderEditBegin(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var orderId string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			orderId = string(vars["id"])
+			return httpmock.NewStringResponse(200, `{"data":{"orderEditBegin":{
+				"calculatedOrder":{
+					"id":"gid://shopify/CalculatedOrder/1",
+					"originalOrder":{"id":"gid://shopify/Order/450789469"},
+					"subtotalLineItemsQuantity":1,
+					"totalPriceSet":{"shopMoney":{"amount":"409.94","currencyCode":"USD"},"presentmentMoney":{"amount":"409.94","currencyCode":"USD"}},
+					"lineItems":{"nodes":[{"id":"gid://shopify/CalculatedLineItem/466157049","quantity":1,"editableQuantity":1,"variant":{"id":"gid://shopify/ProductVariant/39072856"}}]},
+					"addedLineItems":{"nodes":[]}
+				},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	calculatedOrder, err := client.OrderEdit.Begin(context.Background(), 450789469)
+	if err != nil {
+		t.Fatalf("OrderEdit.Begin returned error: %v", err)
+	}
+
+	if orderId != `"gid://shopify/Order/450789469"` {
+		t.Errorf("OrderEdit.Begin sent id %s", orderId)
+	}
+
+	if calculatedOrder.Id.String() != "gid://shopify/CalculatedOrder/1" || calculatedOrder.OriginalOrderId.Id != 450789469 {
+		t.Errorf("OrderEdit.Begin returned %+v", calculatedOrder)
+	}
+
+	total := decimal.NewFromFloat(409.94)
+	if !calculatedOrder.TotalPriceSet.ShopMoney.Amount.Equal(total) {
+		t.Errorf("OrderEdit.Begin returned total %s, expected %s", calculatedOrder.TotalPriceSet.ShopMoney.Amount, total)
+	}
+
+	if len(calculatedOrder.LineItems) != 1 || calculatedOrder.LineItems[0].VariantId.Id != 39072856 || calculatedOrder.AddedLineItems == nil {
+		t.Errorf("OrderEdit.Begin returned line items %+v, added line items %+v", calculatedOrder.LineItems, calculatedOrder.AddedLineItems)
+	}
+}
+
+func TestOrderEditEdit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	operations := []string{}
+	variables := map[string]map[string]json.RawMessage{}
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			name := GraphQLOperationName(q)
+			operations = append(operations, name)
+			variables[name] = vars
+
+			switch name {
+			case "orderEditBegin":
+				return httpmock.NewStringResponse(200, `{"data":{"orderEditBegin":{
+					"calculatedOrder":{"id":"gid://shopify/CalculatedOrder/1","lineItems":{"nodes":[]},"addedLineItems":{"nodes":[]}},
+					"userErrors":[]
+				}}}`), nil
+			case "orderEditSetQuantity":
+				return httpmock.NewStringResponse(200, `{"data":{"orderEditSetQuantity":{
+					"calculatedLineItem":{"id":"gid://shopify/CalculatedLineItem/466157049","quantity":0},
+					"userErrors":[]
+				}}}`), nil
+			case "orderEditAddVariant":
+				return httpmock.NewStringResponse(200, `{"data":{"orderEditAddVariant":{
+					"calculatedLineItem":`+testCalculatedLineItemResponse+`,
+					"userErrors":[]
+				}}}`), nil
+			case "orderEditAddLineItemDiscount":
+				return httpmock.NewStringResponse(200, `{"data":{"orderEditAddLineItemDiscount":{
+					"calculatedLineItem":`+testCalculatedLineItemResponse+`,
+					"userErrors":[]
+				}}}`), nil
+			case "orderEditCommit":
+				return httpmock.NewStringResponse(200, `{"data":{"orderEditCommit":{
+					"order":{"id":"gid://shopify/Order/450789469"},
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(400, ""), nil
+		},
+	)
+
+	percent := 10.0
+	orderId, err := client.OrderEdit.Edit(context.Background(), 450789469, []OrderEditChange{
+		{SetQuantity: &OrderEditSetQuantityInput{LineItemId: NewGID(GIDResourceCalculatedLineItem, 466157049), Quantity: 0, Restock: true}},
+		{AddVariant: &OrderEditAddVariantInput{
+			VariantId: 808950810,
+			Quantity:  2,
+			Discount:  &OrderEditAppliedDiscountInput{Description: "sorry", PercentValue: &percent},
+		}},
+	}, OrderEditCommitOptions{NotifyCustomer: true, StaffNote: "swapped item"})
+	if err != nil {
+		t.Fatalf("OrderEdit.Edit returned error: %v", err)
+	}
+
+	if orderId.Id != 450789469 {
+		t.Errorf("OrderEdit.Edit returned order id %s", orderId)
+	}
+
+	expectedOperations := "orderEditBegin,orderEditSetQuantity,orderEditAddVariant,orderEditAddLineItemDiscount,orderEditCommit"
+	if strings.Join(operations, ",") != expectedOperations {
+		t.Errorf("OrderEdit.Edit ran %v, expected %s", operations, expectedOperations)
+	}
+
+	expectedVars := map[string]map[string]string{
+		"orderEditSetQuantity": {
+			"id":         `"gid://shopify/CalculatedOrder/1"`,
+			"lineItemId": `"gid://shopify/CalculatedLineItem/466157049"`,
+			"quantity":   `0`,
+			"restock":    `true`,
+		},
+		"orderEditAddVariant": {
+			"variantId": `"gid://shopify/ProductVariant/808950810"`,
+			"quantity":  `2`,
+		},
+		"orderEditAddLineItemDiscount": {
+			"lineItemId": `"gid://shopify/CalculatedLineItem/e5ab1dd6-6c2d-4c80-bc5a-3efdc5ad1e8a"`,
+			"discount":   `{"description":"sorry","percentValue":10}`,
+		},
+		"orderEditCommit": {
+			"id":             `"gid://shopify/CalculatedOrder/1"`,
+			"notifyCustomer": `true`,
+			"staffNote":      `"swapped item"`,
+		},
+	}
+
+	for operation, expected := range expectedVars {
+		for k, v := range expected {
+			if string(variables[operation][k]) != v {
+				t.Errorf("OrderEdit.Edit %s sent %s=%s, expected %s", operation, k, variables[operation][k], v)
+			}
+		}
+	}
+}
+
+func TestOrderEditSetQuantityUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"orderEditSetQuantity":{
+			"calculatedLineItem":null,
+			"userErrors":[{"field":["quantity"],"message":"Quantity must be greater than or equal to 0"}]
+		}}}`),
+	)
+
+	_, err := client.OrderEdit.SetQuantity(context.Background(), NewGID(GIDResourceCalculatedOrder, 1), OrderEditSetQuantityInput{
+		LineItemId: NewGID(GIDResourceCalculatedLineItem, 466157049),
+		Quantity:   -1,
+	})
+
+	expected := "quantity: Quantity must be greater than or equal to 0"
+	if err == nil || err.Error() != expected {
+		t.Errorf("OrderEdit.SetQuantity returned error %v, expected %s", err, expected)
+	}
+}
+
+func TestOrderEditBeginNoCalculatedOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"orderEditBegin":{
+			"calculatedOrder":null,
+			"userErrors":[]
+		}}}`),
+	)
+
+	calculatedOrder, err := client.OrderEdit.Begin(context.Background(), 1)
+
+	expected := "order edit was not started, no calculated order returned"
+	if err == nil || err.Error() != expected {
+		t.Errorf("OrderEdit.Begin returned error %v, expected %s", err, expected)
+	}
+
+	if calculatedOrder != nil {
+		t.Errorf("OrderEdit.Begin returned %+v, expected nil", calculatedOrder)
+	}
+}
+
+func TestOrderEditCommitNoOrder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"orderEditCommit":{
+			"order":null,
+			"userErrors":[]
+		}}}`),
+	)
+
+	_, err := client.OrderEdit.Commit(context.Background(), NewGID(GIDResourceCalculatedOrder, 1), OrderEditCommitOptions{})
+
+	expected := "order edit commit returned no order"
+	if err == nil || err.Error() != expected {
+		t.Errorf("OrderEdit.Commit returned error %v, expected %s", err, expected)
+	}
+}
@@ -0,0 +1,463 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// priceListFixedPricesLimit is the maximum number of prices the fixed price
+// mutations accept per call
+const priceListFixedPricesLimit = 250
+
+// PriceListService is an interface for managing price lists and their fixed
+// prices through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/markets/pricing
+type PriceListService interface {
+	List(context.Context) ([]PriceList, error)
+	Get(context.Context, GID) (*PriceList, error)
+	Create(context.Context, PriceListInput) (*PriceList, error)
+	Update(context.Context, GID, PriceListInput) (*PriceList, error)
+	Delete(context.Context, GID) error
+	ListFixedPrices(context.Context, GID) ([]PriceListPrice, error)
+	AddFixedPrices(context.Context, GID, []PriceListPriceInput) ([]PriceListPrice, error)
+	DeleteFixedPrices(context.Context, GID, []GID) ([]GID, error)
+}
+
+// PriceListServiceOp handles communication with the price list related
+// methods of the Shopify API.
+type PriceListServiceOp struct {
+	client *Client
+}
+
+// PriceListAdjustmentType is the direction of the percentage adjustment of a
+// price list relative to the product prices
+type PriceListAdjustmentType string
+
+const (
+	PriceListAdjustmentTypePercentageDecrease PriceListAdjustmentType = "PERCENTAGE_DECREASE"
+	PriceListAdjustmentTypePercentageIncrease PriceListAdjustmentType = "PERCENTAGE_INCREASE"
+)
+
+// PriceListPriceOriginType is whether a price list price is fixed or
+// calculated from the adjustment of the price list
+type PriceListPriceOriginType string
+
+const (
+	PriceListPriceOriginTypeFixed    PriceListPriceOriginType = "FIXED"
+	PriceListPriceOriginTypeRelative PriceListPriceOriginType = "RELATIVE"
+)
+
+// PriceList represents a list of prices in a currency, either fixed per
+// variant or adjusted by a percentage from the product prices
+type PriceList struct {
+	Id               GID               `json:"id"`
+	Name             string            `json:"name,omitempty"`
+	Currency         string            `json:"currency,omitempty"`
+	FixedPricesCount int               `json:"fixedPricesCount,omitempty"`
+	Parent           *PriceListParent  `json:"parent,omitempty"`
+	Catalog          *PriceListCatalog `json:"catalog,omitempty"`
+}
+
+// PriceListParent represents the relative adjustment of a price list
+type PriceListParent struct {
+	Adjustment PriceListAdjustment `json:"adjustment"`
+}
+
+// PriceListAdjustment represents a percentage adjustment, e.g. a Value of
+// 10 with PERCENTAGE_DECREASE is 10% off
+type PriceListAdjustment struct {
+	Type  PriceListAdjustmentType `json:"type"`
+	Value float64                 `json:"value"`
+}
+
+// PriceListCatalog represents the catalog a price list is attached to, the
+// catalog of a market applies the price list to the market
+type PriceListCatalog struct {
+	Id    GID    `json:"id"`
+	Title string `json:"title,omitempty"`
+}
+
+// PriceListPrice represents the price of a variant in a price list
+type PriceListPrice struct {
+	VariantId      GID                      `json:"variantId"`
+	Price          MoneyV2                  `json:"price"`
+	CompareAtPrice *MoneyV2                 `json:"compareAtPrice,omitempty"`
+	OriginType     PriceListPriceOriginType `json:"originType,omitempty"`
+}
+
+// PriceListInput represents the input of the priceListCreate and
+// priceListUpdate mutations
+type PriceListInput struct {
+	Name      string           `json:"name,omitempty"`
+	Currency  string           `json:"currency,omitempty"`
+	Parent    *PriceListParent `json:"parent,omitempty"`
+	CatalogId *GID             `json:"catalogId,omitempty"`
+}
+
+// PriceListPriceInput represents a fixed price to add to a price list, the
+// currency must be the currency of the price list
+type PriceListPriceInput struct {
+	VariantId      GID      `json:"variantId"`
+	Price          MoneyV2  `json:"price"`
+	CompareAtPrice *MoneyV2 `json:"compareAtPrice,omitempty"`
+}
+
+// UnmarshalJSON flattens the variant of the price
+func (p *PriceListPrice) UnmarshalJSON(b []byte) error {
+	type alias PriceListPrice
+	aux := struct {
+		*alias
+		Variant *struct {
+			Id GID `json:"id"`
+		} `json:"variant"`
+	}{alias: (*alias)(p)}
+
+	if err := json.Unmarshal(b, &aux); err != nil {
+		return err
+	}
+
+	if aux.Variant != nil {
+		p.VariantId = aux.Variant.Id
+	}
+
+	return nil
+}
+
+const priceListFields = `
+    id
+    name
+    currency
+    fixedPricesCount
+    parent {
+      adjustment {
+        type
+        value
+      }
+    }
+    catalog {
+      id
+      title
+    }
+`
+
+const priceListPriceFields = `
+      variant {
+        id
+      }
+      price {
+        amount
+        currencyCode
+      }
+      compareAtPrice {
+        amount
+        currencyCode
+      }
+      originType
+`
+
+const priceListsQuery = `query priceLists($after: String) {
+  priceLists(first: 50, after: $after) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {` + priceListFields + `}
+  }
+}`
+
+const priceListQuery = `query priceList($id: ID!) {
+  priceList(id: $id) {` + priceListFields + `}
+}`
+
+const priceListCreateMutation = `mutation priceListCreate($input: PriceListCreateInput!) {
+  priceListCreate(input: $input) {
+    priceList {` + priceListFields + `}
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const priceListUpdateMutation = `mutation priceListUpdate($id: ID!, $input: PriceListUpdateInput!) {
+  priceListUpdate(id: $id, input: $input) {
+    priceList {` + priceListFields + `}
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const priceListDeleteMutation = `mutation priceListDelete($id: ID!) {
+  priceListDelete(id: $id) {
+    deletedId
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const priceListFixedPricesQuery = `query priceListFixedPrices($id: ID!, $after: String) {
+  priceList(id: $id) {
+    prices(first: 250, after: $after, originType: FIXED) {
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+      nodes {` + priceListPriceFields + `}
+    }
+  }
+}`
+
+const priceListFixedPricesAddMutation = `mutation priceListFixedPricesAdd($priceListId: ID!, $prices: [PriceListPriceInput!]!) {
+  priceListFixedPricesAdd(priceListId: $priceListId, prices: $prices) {
+    prices {` + priceListPriceFields + `}
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const priceListFixedPricesDeleteMutation = `mutation priceListFixedPricesDelete($priceListId: ID!, $variantIds: [ID!]!) {
+  priceListFixedPricesDelete(priceListId: $priceListId, variantIds: $variantIds) {
+    deletedFixedPriceVariantIds
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+// List lists all price lists, iterating over pages
+func (s *PriceListServiceOp) List(ctx context.Context) ([]PriceList, error) {
+	collector := []PriceList{}
+	vars := map[string]interface{}{}
+
+	for {
+		resp := struct {
+			PriceLists struct {
+				PageInfo GraphQLPageInfo `json:"pageInfo"`
+				Nodes    []PriceList     `json:"nodes"`
+			} `json:"priceLists"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, priceListsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.PriceLists.Nodes...)
+
+		if !resp.PriceLists.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.PriceLists.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Get retrieves a price list by id
+func (s *PriceListServiceOp) Get(ctx context.Context, id GID) (*PriceList, error) {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		PriceList *PriceList `json:"priceList"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, priceListQuery, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.PriceList, nil
+}
+
+// Create creates a price list, Name, Currency and Parent are required
+func (s *PriceListServiceOp) Create(ctx context.Context, input PriceListInput) (*PriceList, error) {
+	vars := map[string]interface{}{"input": input}
+	resp := struct {
+		PriceListCreate struct {
+			PriceList  *PriceList         `json:"priceList"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"priceListCreate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, priceListCreateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.PriceListCreate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.PriceListCreate.PriceList, nil
+}
+
+// Update updates a price list, only the fields set in the input are changed
+func (s *PriceListServiceOp) Update(ctx context.Context, id GID, input PriceListInput) (*PriceList, error) {
+	vars := map[string]interface{}{"id": id, "input": input}
+	resp := struct {
+		PriceListUpdate struct {
+			PriceList  *PriceList         `json:"priceList"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"priceListUpdate"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, priceListUpdateMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.PriceListUpdate.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.PriceListUpdate.PriceList, nil
+}
+
+// Delete deletes a price list
+func (s *PriceListServiceOp) Delete(ctx context.Context, id GID) error {
+	vars := map[string]interface{}{"id": id}
+	resp := struct {
+		PriceListDelete struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"priceListDelete"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, priceListDeleteMutation, vars, &resp)
+	if err != nil {
+		return err
+	}
+
+	return userErrorsToError(resp.PriceListDelete.UserErrors)
+}
+
+// ListFixedPrices lists all fixed prices of a price list, iterating over
+// pages
+func (s *PriceListServiceOp) ListFixedPrices(ctx context.Context, id GID) ([]PriceListPrice, error) {
+	collector := []PriceListPrice{}
+	vars := map[string]interface{}{"id": id}
+
+	for {
+		resp := struct {
+			PriceList *struct {
+				Prices struct {
+					PageInfo GraphQLPageInfo  `json:"pageInfo"`
+					Nodes    []PriceListPrice `json:"nodes"`
+				} `json:"prices"`
+			} `json:"priceList"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, priceListFixedPricesQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		if resp.PriceList == nil {
+			break
+		}
+
+		collector = append(collector, resp.PriceList.Prices.Nodes...)
+
+		if !resp.PriceList.Prices.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.PriceList.Prices.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// AddFixedPrices adds or updates fixed prices of a price list, sending the
+// prices 250 at a time. A batch is saved atomically, if any of its prices
+// fails none of them are saved, but the other batches are still sent. The
+// saved prices are returned along with the user errors of the failed
+// batches, the indexes in the error fields refer to the prices passed in.
+func (s *PriceListServiceOp) AddFixedPrices(ctx context.Context, id GID, prices []PriceListPriceInput) ([]PriceListPrice, error) {
+	collector := []PriceListPrice{}
+	var userErrors []GraphQLUserError
+
+	for offset := 0; offset < len(prices); offset += priceListFixedPricesLimit {
+		end := offset + priceListFixedPricesLimit
+		if end > len(prices) {
+			end = len(prices)
+		}
+
+		vars := map[string]interface{}{"priceListId": id, "prices": prices[offset:end]}
+		resp := struct {
+			PriceListFixedPricesAdd struct {
+				Prices     []PriceListPrice   `json:"prices"`
+				UserErrors []GraphQLUserError `json:"userErrors"`
+			} `json:"priceListFixedPricesAdd"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, priceListFixedPricesAddMutation, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		userErrors = append(userErrors, offsetUserErrors(resp.PriceListFixedPricesAdd.UserErrors, "prices", offset)...)
+		collector = append(collector, resp.PriceListFixedPricesAdd.Prices...)
+	}
+
+	return collector, userErrorsToError(userErrors)
+}
+
+// DeleteFixedPrices deletes the fixed prices of variants from a price list,
+// sending the variants 250 at a time, and returns the ids of the variants
+// whose fixed price was deleted. Failed batches are handled like
+// AddFixedPrices.
+func (s *PriceListServiceOp) DeleteFixedPrices(ctx context.Context, id GID, variantIds []GID) ([]GID, error) {
+	collector := []GID{}
+	var userErrors []GraphQLUserError
+
+	for offset := 0; offset < len(variantIds); offset += priceListFixedPricesLimit {
+		end := offset + priceListFixedPricesLimit
+		if end > len(variantIds) {
+			end = len(variantIds)
+		}
+
+		vars := map[string]interface{}{"priceListId": id, "variantIds": variantIds[offset:end]}
+		resp := struct {
+			PriceListFixedPricesDelete struct {
+				DeletedFixedPriceVariantIds []GID              `json:"deletedFixedPriceVariantIds"`
+				UserErrors                  []GraphQLUserError `json:"userErrors"`
+			} `json:"priceListFixedPricesDelete"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, priceListFixedPricesDeleteMutation, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		userErrors = append(userErrors, offsetUserErrors(resp.PriceListFixedPricesDelete.UserErrors, "variantIds", offset)...)
+		collector = append(collector, resp.PriceListFixedPricesDelete.DeletedFixedPriceVariantIds...)
+	}
+
+	return collector, userErrorsToError(userErrors)
+}
+
+// offsetUserErrors shifts the index in the fields of the user errors of a
+// batch, e.g. prices.3.price, by the offset of the batch
+func offsetUserErrors(userErrors []GraphQLUserError, argument string, offset int) []GraphQLUserError {
+	shifted := make([]GraphQLUserError, 0, len(userErrors))
+	for _, userErr := range userErrors {
+		if i, field := batchUserErrorIndex(userErr, argument, offset); i >= 0 {
+			userErr.Field = append([]string{argument, strconv.Itoa(i)}, field...)
+		}
+		shifted = append(shifted, userErr)
+	}
+	return shifted
+}
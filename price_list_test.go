@@ -0,0 +1,281 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestPriceListList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"priceLists":{
+			"pageInfo":{"hasNextPage":false},
+			"nodes":[{
+				"id":"gid://shopify/PriceList/7",
+				"name":"Europe",
+				"currency":"EUR",
+				"fixedPricesCount":2,
+				"parent":{"adjustment":{"type":"PERCENTAGE_INCREASE","value":10}},
+				"catalog":{"id":"gid://shopify/MarketCatalog/3","title":"Europe"}
+			}]
+		}}}`),
+	)
+
+	priceLists, err := client.PriceList.List(context.Background())
+	if err != nil {
+		t.Fatalf("PriceList.List returned error: %v", err)
+	}
+
+	expected := []PriceList{{
+		Id:               NewGID(GIDResourcePriceList, 7),
+		Name:             "Europe",
+		Currency:         "EUR",
+		FixedPricesCount: 2,
+		Parent:           &PriceListParent{Adjustment: PriceListAdjustment{Type: PriceListAdjustmentTypePercentageIncrease, Value: 10}},
+		Catalog:          &PriceListCatalog{Id: NewGID("MarketCatalog", 3), Title: "Europe"},
+	}}
+	if !reflect.DeepEqual(priceLists, expected) {
+		t.Errorf("PriceList.List returned %+v, expected %+v", priceLists, expected)
+	}
+}
+
+func TestPriceListCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["input"])
+			return httpmock.NewStringResponse(200, `{"data":{"priceListCreate":{
+				"priceList":{"id":"gid://shopify/PriceList/7","name":"Europe","currency":"EUR"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	catalogId := NewGID("MarketCatalog", 3)
+	priceList, err := client.PriceList.Create(context.Background(), PriceListInput{
+		Name:      "Europe",
+		Currency:  "EUR",
+		Parent:    &PriceListParent{Adjustment: PriceListAdjustment{Type: PriceListAdjustmentTypePercentageDecrease, Value: 5}},
+		CatalogId: &catalogId,
+	})
+	if err != nil {
+		t.Fatalf("PriceList.Create returned error: %v", err)
+	}
+
+	expectedInput := `{"name":"Europe","currency":"EUR","parent":{"adjustment":{"type":"PERCENTAGE_DECREASE","value":5}},"catalogId":"gid://shopify/MarketCatalog/3"}`
+	if input != expectedInput {
+		t.Errorf("PriceList.Create sent %s, expected %s", input, expectedInput)
+	}
+
+	if priceList.Id.Id != 7 {
+		t.Errorf("PriceList.Create returned %+v", priceList)
+	}
+}
+
+func TestPriceListUpdateAndDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query, _ := graphQLRequestBody(req)
+			if GraphQLOperationName(query) == "priceListDelete" {
+				return httpmock.NewStringResponse(200, `{"data":{"priceListDelete":{
+					"deletedId":null,
+					"userErrors":[{"field":["id"],"message":"Price list does not exist","code":"PRICE_LIST_NOT_FOUND"}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"priceListUpdate":{
+				"priceList":{"id":"gid://shopify/PriceList/7","name":"EU"},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	priceList, err := client.PriceList.Update(context.Background(), NewGID(GIDResourcePriceList, 7), PriceListInput{Name: "EU"})
+	if err != nil {
+		t.Fatalf("PriceList.Update returned error: %v", err)
+	}
+	if priceList.Name != "EU" {
+		t.Errorf("PriceList.Update returned %+v", priceList)
+	}
+
+	err = client.PriceList.Delete(context.Background(), NewGID(GIDResourcePriceList, 7))
+	expectedErr := "id: Price list does not exist"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("PriceList.Delete returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestPriceListListFixedPrices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"priceList":{"prices":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{
+						"variant":{"id":"gid://shopify/ProductVariant/1"},
+						"price":{"amount":"10.0","currencyCode":"EUR"},
+						"compareAtPrice":{"amount":"12.0","currencyCode":"EUR"},
+						"originType":"FIXED"
+					}]
+				}}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"priceList":{"prices":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[{
+					"variant":{"id":"gid://shopify/ProductVariant/2"},
+					"price":{"amount":"20.0","currencyCode":"EUR"},
+					"compareAtPrice":null,
+					"originType":"FIXED"
+				}]
+			}}}}`), nil
+		},
+	)
+
+	prices, err := client.PriceList.ListFixedPrices(context.Background(), NewGID(GIDResourcePriceList, 7))
+	if err != nil {
+		t.Fatalf("PriceList.ListFixedPrices returned error: %v", err)
+	}
+
+	ten, twelve, twenty := decimal.RequireFromString("10.0"), decimal.RequireFromString("12.0"), decimal.RequireFromString("20.0")
+	expected := []PriceListPrice{
+		{
+			VariantId:      NewGID(GIDResourceProductVariant, 1),
+			Price:          MoneyV2{Amount: &ten, CurrencyCode: "EUR"},
+			CompareAtPrice: &MoneyV2{Amount: &twelve, CurrencyCode: "EUR"},
+			OriginType:     PriceListPriceOriginTypeFixed,
+		},
+		{
+			VariantId:  NewGID(GIDResourceProductVariant, 2),
+			Price:      MoneyV2{Amount: &twenty, CurrencyCode: "EUR"},
+			OriginType: PriceListPriceOriginTypeFixed,
+		},
+	}
+	if !reflect.DeepEqual(prices, expected) {
+		t.Errorf("PriceList.ListFixedPrices returned %+v, expected %+v", prices, expected)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("PriceList.ListFixedPrices requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+}
+
+func TestPriceListAddFixedPrices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batchSizes []int
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			var batch []PriceListPriceInput
+			if err := json.Unmarshal(vars["prices"], &batch); err != nil {
+				return nil, err
+			}
+			batchSizes = append(batchSizes, len(batch))
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"data":{"priceListFixedPricesAdd":{
+				"prices":[{"variant":{"id":%q},"price":{"amount":"9.99","currencyCode":"EUR"}}],
+				"userErrors":[]
+			}}}`, batch[0].VariantId.String())), nil
+		},
+	)
+
+	amount := decimal.RequireFromString("9.99")
+	inputs := make([]PriceListPriceInput, 260)
+	for i := range inputs {
+		inputs[i] = PriceListPriceInput{
+			VariantId: NewGID(GIDResourceProductVariant, uint64(i+1)),
+			Price:     MoneyV2{Amount: &amount, CurrencyCode: "EUR"},
+		}
+	}
+
+	prices, err := client.PriceList.AddFixedPrices(context.Background(), NewGID(GIDResourcePriceList, 7), inputs)
+	if err != nil {
+		t.Fatalf("PriceList.AddFixedPrices returned error: %v", err)
+	}
+
+	expectedBatchSizes := []int{250, 10}
+	if !reflect.DeepEqual(batchSizes, expectedBatchSizes) {
+		t.Errorf("PriceList.AddFixedPrices sent batches of %+v, expected %+v", batchSizes, expectedBatchSizes)
+	}
+
+	if len(prices) != 2 || prices[1].VariantId.Id != 251 {
+		t.Errorf("PriceList.AddFixedPrices returned %+v", prices)
+	}
+}
+
+func TestPriceListDeleteFixedPricesUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			if calls == 2 {
+				return httpmock.NewStringResponse(200, `{"data":{"priceListFixedPricesDelete":{
+					"deletedFixedPriceVariantIds":[],
+					"userErrors":[{"field":["variantIds","1"],"message":"Variant has no fixed price","code":"VARIANT_NOT_FOUND"}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"data":{"priceListFixedPricesDelete":{
+				"deletedFixedPriceVariantIds":["gid://shopify/ProductVariant/%d"],
+				"userErrors":[]
+			}}}`, calls)), nil
+		},
+	)
+
+	variantIds := make([]GID, 501)
+	for i := range variantIds {
+		variantIds[i] = NewGID(GIDResourceProductVariant, uint64(i+1))
+	}
+
+	deleted, err := client.PriceList.DeleteFixedPrices(context.Background(), NewGID(GIDResourcePriceList, 7), variantIds)
+
+	expectedErr := "variantIds.251: Variant has no fixed price"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("PriceList.DeleteFixedPrices returned error %v, expected %s", err, expectedErr)
+	}
+
+	if calls != 3 {
+		t.Errorf("PriceList.DeleteFixedPrices sent %d batches, expected 3", calls)
+	}
+
+	expected := []GID{NewGID(GIDResourceProductVariant, 1), NewGID(GIDResourceProductVariant, 3)}
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("PriceList.DeleteFixedPrices returned %+v, expected %+v", deleted, expected)
+	}
+}
@@ -18,6 +18,9 @@ type PriceRuleService interface {
 	Create(context.Context, PriceRule) (*PriceRule, error)
 	Update(context.Context, PriceRule) (*PriceRule, error)
 	List(context.Context) ([]PriceRule, error)
+	ListAll(context.Context, interface{}) ([]PriceRule, error)
+	ListWithPagination(context.Context, interface{}) ([]PriceRule, *Pagination, error)
+	Count(context.Context, interface{}) (int, error)
 	Delete(context.Context, uint64) error
 }
 
@@ -26,6 +29,16 @@ type PriceRuleServiceOp struct {
 	client *Client
 }
 
+// PriceRuleListOptions represents the options available when listing price rules
+type PriceRuleListOptions struct {
+	ListOptions
+	StartsAtMin *time.Time `url:"starts_at_min,omitempty"`
+	StartsAtMax *time.Time `url:"starts_at_max,omitempty"`
+	EndsAtMin   *time.Time `url:"ends_at_min,omitempty"`
+	EndsAtMax   *time.Time `url:"ends_at_max,omitempty"`
+	TimesUsed   *int       `url:"times_used,omitempty"`
+}
+
 // PriceRule represents a Shopify discount rule
 type PriceRule struct {
 	Id                                     uint64                                  `json:"id,omitempty"`
@@ -168,6 +181,51 @@ func (s *PriceRuleServiceOp) List(ctx context.Context) ([]PriceRule, error) {
 	return resource.PriceRules, err
 }
 
+// ListAll Lists all price rules matching the options, e.g.
+// PriceRuleListOptions, iterating over pages
+func (s *PriceRuleServiceOp) ListAll(ctx context.Context, options interface{}) ([]PriceRule, error) {
+	collector := []PriceRule{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+// ListWithPagination lists price rules matching the options, e.g.
+// PriceRuleListOptions, and returns pagination to retrieve next/previous
+// results
+func (s *PriceRuleServiceOp) ListWithPagination(ctx context.Context, options interface{}) ([]PriceRule, *Pagination, error) {
+	path := fmt.Sprintf("%s.json", priceRulesBasePath)
+	resource := new(PriceRulesResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.PriceRules, pagination, nil
+}
+
+// Count retrieves the number of price rules
+func (s *PriceRuleServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/count.json", priceRulesBasePath)
+	return s.client.Count(ctx, path, options)
+}
+
 // Create creates a price rule
 func (s *PriceRuleServiceOp) Create(ctx context.Context, pr PriceRule) (*PriceRule, error) {
 	path := fmt.Sprintf("%s.json", priceRulesBasePath)
@@ -3,7 +3,11 @@ package goshopify
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -216,3 +220,61 @@ func TestPriceRuleSetters(t *testing.T) {
 		t.Errorf("Failed to clear wholly prerequisite to entitlement quantity ratio")
 	}
 }
+
+func TestPriceRuleListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules.json", client.pathPrefix)
+
+	var query url.Values
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		resp := httpmock.NewStringResponse(200, `{"price_rules":[{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	startsAtMin := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	timesUsed := 0
+	rules, pagination, err := client.PriceRule.ListWithPagination(context.Background(), PriceRuleListOptions{
+		ListOptions: ListOptions{Limit: 2},
+		StartsAtMin: &startsAtMin,
+		TimesUsed:   &timesUsed,
+	})
+	if err != nil {
+		t.Errorf("PriceRule.ListWithPagination returned error: %v", err)
+	}
+
+	expectedQuery := url.Values{"limit": {"2"}, "starts_at_min": {"2024-01-01T00:00:00Z"}, "times_used": {"0"}}
+	if !reflect.DeepEqual(query, expectedQuery) {
+		t.Errorf("PriceRule.ListWithPagination sent %v, expected %v", query, expectedQuery)
+	}
+
+	if len(rules) != 2 {
+		t.Errorf("PriceRule.ListWithPagination returned %d rules, expected 2", len(rules))
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pg2", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("PriceRule.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestPriceRuleCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 7}`))
+
+	cnt, err := client.PriceRule.Count(context.Background(), nil)
+	if err != nil {
+		t.Errorf("PriceRule.Count returned error: %v", err)
+	}
+
+	expected := 7
+	if cnt != expected {
+		t.Errorf("PriceRule.Count returned %d, expected %d", cnt, expected)
+	}
+}
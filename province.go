@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProvinceService is an interface for interfacing with the province endpoints
+// of the Shopify API. Provinces are created and deleted together with their
+// country, so only reading and updating is supported.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/province
+type ProvinceService interface {
+	List(context.Context, uint64, interface{}) ([]Province, error)
+	Count(context.Context, uint64, interface{}) (int, error)
+	Get(context.Context, uint64, uint64, interface{}) (*Province, error)
+	Update(context.Context, uint64, Province) (*Province, error)
+}
+
+// ProvinceServiceOp handles communication with the province related methods
+// of the Shopify API.
+type ProvinceServiceOp struct {
+	client *Client
+}
+
+// ProvinceTaxType describes how a province tax is combined with the tax of
+// its country
+type ProvinceTaxType string
+
+const (
+	// ProvinceTaxTypeNormal is added to the country tax
+	ProvinceTaxTypeNormal ProvinceTaxType = "normal"
+
+	// ProvinceTaxTypeCompounded is applied on top of the country tax
+	ProvinceTaxTypeCompounded ProvinceTaxType = "compounded"
+
+	// ProvinceTaxTypeHarmonized replaces the country tax
+	ProvinceTaxTypeHarmonized ProvinceTaxType = "harmonized"
+)
+
+// Province represents a Shopify province and its tax configuration
+type Province struct {
+	Id             uint64           `json:"id,omitempty"`
+	CountryId      uint64           `json:"country_id,omitempty"`
+	ShippingZoneId uint64           `json:"shipping_zone_id,omitempty"`
+	Name           string           `json:"name,omitempty"`
+	Code           string           `json:"code,omitempty"`
+	Tax            *decimal.Decimal `json:"tax,omitempty"`
+	TaxName        string           `json:"tax_name,omitempty"`
+	TaxType        ProvinceTaxType  `json:"tax_type,omitempty"`
+	TaxPercentage  *decimal.Decimal `json:"tax_percentage,omitempty"`
+}
+
+// ProvinceResource represents the result from the countries/X/provinces/Y.json endpoint
+type ProvinceResource struct {
+	Province *Province `json:"province"`
+}
+
+// ProvincesResource represents the result from the countries/X/provinces.json endpoint
+type ProvincesResource struct {
+	Provinces []Province `json:"provinces"`
+}
+
+// List provinces of a country
+func (s *ProvinceServiceOp) List(ctx context.Context, countryId uint64, options interface{}) ([]Province, error) {
+	path := fmt.Sprintf("%s/%d/provinces.json", countriesBasePath, countryId)
+	resource := new(ProvincesResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Provinces, err
+}
+
+// Count provinces of a country
+func (s *ProvinceServiceOp) Count(ctx context.Context, countryId uint64, options interface{}) (int, error) {
+	path := fmt.Sprintf("%s/%d/provinces/count.json", countriesBasePath, countryId)
+	return s.client.Count(ctx, path, options)
+}
+
+// Get individual province
+func (s *ProvinceServiceOp) Get(ctx context.Context, countryId, provinceId uint64, options interface{}) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/provinces/%d.json", countriesBasePath, countryId, provinceId)
+	resource := new(ProvinceResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Province, err
+}
+
+// Update an existing province, e.g. to override its tax rate
+func (s *ProvinceServiceOp) Update(ctx context.Context, countryId uint64, province Province) (*Province, error) {
+	path := fmt.Sprintf("%s/%d/provinces/%d.json", countriesBasePath, countryId, province.Id)
+	wrappedData := ProvinceResource{Province: &province}
+	resource := new(ProvinceResource)
+	err := s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Province, err
+}
@@ -0,0 +1,109 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func provinceTests(t *testing.T, province Province) {
+	expectedId := uint64(224293623)
+	if province.Id != expectedId {
+		t.Errorf("Province.Id returned %+v, expected %+v", province.Id, expectedId)
+	}
+
+	expectedCountryId := uint64(879921427)
+	if province.CountryId != expectedCountryId {
+		t.Errorf("Province.CountryId returned %+v, expected %+v", province.CountryId, expectedCountryId)
+	}
+
+	expectedTaxType := ProvinceTaxTypeCompounded
+	if province.TaxType != expectedTaxType {
+		t.Errorf("Province.TaxType returned %+v, expected %+v", province.TaxType, expectedTaxType)
+	}
+
+	expectedTax := decimal.NewFromFloat(0.09975)
+	if province.Tax == nil || !province.Tax.Equal(expectedTax) {
+		t.Errorf("Province.Tax returned %+v, expected %+v", province.Tax, expectedTax)
+	}
+
+	expectedTaxPercentage := decimal.NewFromFloat(9.975)
+	if province.TaxPercentage == nil || !province.TaxPercentage.Equal(expectedTaxPercentage) {
+		t.Errorf("Province.TaxPercentage returned %+v, expected %+v", province.TaxPercentage, expectedTaxPercentage)
+	}
+}
+
+func TestProvinceList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427/provinces.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("provinces.json")))
+
+	provinces, err := client.Province.List(context.Background(), 879921427, nil)
+	if err != nil {
+		t.Errorf("Province.List returned error: %v", err)
+	}
+
+	if len(provinces) != 2 {
+		t.Fatalf("Province.List returned %d provinces, expected 2", len(provinces))
+	}
+
+	if provinces[0].Code != "AB" || provinces[0].TaxType != "" {
+		t.Errorf("Province.List returned %+v", provinces[0])
+	}
+
+	provinceTests(t, provinces[1])
+}
+
+func TestProvinceCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427/provinces/count.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"count": 13}`))
+
+	cnt, err := client.Province.Count(context.Background(), 879921427, nil)
+	if err != nil {
+		t.Errorf("Province.Count returned error: %v", err)
+	}
+
+	expected := 13
+	if cnt != expected {
+		t.Errorf("Province.Count returned %d, expected %d", cnt, expected)
+	}
+}
+
+func TestProvinceGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427/provinces/224293623.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("province.json")))
+
+	province, err := client.Province.Get(context.Background(), 879921427, 224293623, nil)
+	if err != nil {
+		t.Fatalf("Province.Get returned error: %v", err)
+	}
+
+	provinceTests(t, *province)
+}
+
+func TestProvinceUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/countries/879921427/provinces/224293623.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("province.json")))
+
+	tax := decimal.NewFromFloat(0.09975)
+	province, err := client.Province.Update(context.Background(), 879921427, Province{Id: 224293623, Tax: &tax})
+	if err != nil {
+		t.Fatalf("Province.Update returned error: %v", err)
+	}
+
+	provinceTests(t, *province)
+}
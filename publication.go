@@ -0,0 +1,136 @@
+package goshopify
+
+import (
+	"context"
+	"time"
+)
+
+// PublicationService is an interface for publishing products and collections
+// to sales channels through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-graphql/latest/objects/Publication
+type PublicationService interface {
+	List(context.Context) ([]Publication, error)
+	Publish(context.Context, GID, []PublicationInput) error
+	Unpublish(context.Context, GID, []PublicationInput) error
+}
+
+// PublicationServiceOp handles communication with the publication related
+// methods of the Shopify API.
+type PublicationServiceOp struct {
+	client *Client
+}
+
+// Publication represents a group of products and collections published to a
+// sales channel
+type Publication struct {
+	Id                       GID    `json:"id"`
+	Name                     string `json:"name,omitempty"`
+	AutoPublish              bool   `json:"autoPublish"`
+	SupportsFuturePublishing bool   `json:"supportsFuturePublishing"`
+}
+
+// PublicationInput represents a publication a resource is published to or
+// unpublished from. PublishDate schedules the publication and is only
+// supported by publications that support future publishing.
+type PublicationInput struct {
+	PublicationId GID        `json:"publicationId"`
+	PublishDate   *time.Time `json:"publishDate,omitempty"`
+}
+
+const publicationsQuery = `query publications($after: String) {
+  publications(first: 250, after: $after) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {
+      id
+      name
+      autoPublish
+      supportsFuturePublishing
+    }
+  }
+}`
+
+const publishablePublishMutation = `mutation publishablePublish($id: ID!, $input: [PublicationInput!]!) {
+  publishablePublish(id: $id, input: $input) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+const publishableUnpublishMutation = `mutation publishableUnpublish($id: ID!, $input: [PublicationInput!]!) {
+  publishableUnpublish(id: $id, input: $input) {
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// List lists all publications of the shop, iterating over pages
+func (s *PublicationServiceOp) List(ctx context.Context) ([]Publication, error) {
+	collector := []Publication{}
+	vars := map[string]interface{}{}
+
+	for {
+		resp := struct {
+			Publications struct {
+				PageInfo GraphQLPageInfo `json:"pageInfo"`
+				Nodes    []Publication   `json:"nodes"`
+			} `json:"publications"`
+		}{}
+
+		err := s.client.GraphQL.Query(ctx, publicationsQuery, vars, &resp)
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, resp.Publications.Nodes...)
+
+		if !resp.Publications.PageInfo.HasNextPage {
+			break
+		}
+
+		vars["after"] = resp.Publications.PageInfo.EndCursor
+	}
+
+	return collector, nil
+}
+
+// Publish publishes a resource, e.g. a product or collection, to the given
+// publications
+func (s *PublicationServiceOp) Publish(ctx context.Context, id GID, input []PublicationInput) error {
+	resp := struct {
+		PublishablePublish struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"publishablePublish"`
+	}{}
+
+	return s.mutate(ctx, publishablePublishMutation, id, input, &resp, &resp.PublishablePublish.UserErrors)
+}
+
+// Unpublish unpublishes a resource, e.g. a product or collection, from the
+// given publications
+func (s *PublicationServiceOp) Unpublish(ctx context.Context, id GID, input []PublicationInput) error {
+	resp := struct {
+		PublishableUnpublish struct {
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"publishableUnpublish"`
+	}{}
+
+	return s.mutate(ctx, publishableUnpublishMutation, id, input, &resp, &resp.PublishableUnpublish.UserErrors)
+}
+
+func (s *PublicationServiceOp) mutate(ctx context.Context, q string, id GID, input []PublicationInput, resp interface{}, userErrors *[]GraphQLUserError) error {
+	vars := map[string]interface{}{"id": id, "input": input}
+
+	err := s.client.GraphQL.Query(ctx, q, vars, resp)
+	if err != nil {
+		return err
+	}
+
+	return userErrorsToError(*userErrors)
+}
@@ -0,0 +1,124 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestPublicationList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"publications":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"id":"gid://shopify/Publication/1","name":"Online Store","autoPublish":true,"supportsFuturePublishing":true}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"publications":{
+				"pageInfo":{"hasNextPage":false,"endCursor":"def"},
+				"nodes":[{"id":"gid://shopify/Publication/2","name":"Point of Sale","autoPublish":false,"supportsFuturePublishing":false}]
+			}}}`), nil
+		},
+	)
+
+	publications, err := client.Publication.List(context.Background())
+	if err != nil {
+		t.Fatalf("Publication.List returned error: %v", err)
+	}
+
+	expected := []Publication{
+		{Id: NewGID(GIDResourcePublication, 1), Name: "Online Store", AutoPublish: true, SupportsFuturePublishing: true},
+		{Id: NewGID(GIDResourcePublication, 2), Name: "Point of Sale"},
+	}
+	if !reflect.DeepEqual(publications, expected) {
+		t.Errorf("Publication.List returned %+v, expected %+v", publications, expected)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("Publication.List requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+}
+
+func TestPublicationPublish(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query, id, input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, vars := graphQLRequestBody(req)
+			query, id, input = q, string(vars["id"]), string(vars["input"])
+			return httpmock.NewStringResponse(200, `{"data":{"publishablePublish":{"userErrors":[]}}}`), nil
+		},
+	)
+
+	publishDate := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	err := client.Publication.Publish(context.Background(), NewGID(GIDResourceCollection, 482865238), []PublicationInput{
+		{PublicationId: NewGID(GIDResourcePublication, 1)},
+		{PublicationId: NewGID(GIDResourcePublication, 2), PublishDate: &publishDate},
+	})
+	if err != nil {
+		t.Fatalf("Publication.Publish returned error: %v", err)
+	}
+
+	if GraphQLOperationName(query) != "publishablePublish" {
+		t.Errorf("Publication.Publish sent query %s", query)
+	}
+
+	expectedId := `"gid://shopify/Collection/482865238"`
+	if id != expectedId {
+		t.Errorf("Publication.Publish sent id %s, expected %s", id, expectedId)
+	}
+
+	expectedInput := `[{"publicationId":"gid://shopify/Publication/1"},{"publicationId":"gid://shopify/Publication/2","publishDate":"2024-02-01T00:00:00Z"}]`
+	if input != expectedInput {
+		t.Errorf("Publication.Publish sent input %s, expected %s", input, expectedInput)
+	}
+}
+
+func TestPublicationUnpublishUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query, _ = graphQLRequestBody(req)
+			return httpmock.NewStringResponse(200, `{"data":{"publishableUnpublish":{"userErrors":[
+				{"field":["id"],"message":"Product does not exist"}
+			]}}}`), nil
+		},
+	)
+
+	err := client.Publication.Unpublish(context.Background(), NewGID(GIDResourceProduct, 1), []PublicationInput{
+		{PublicationId: NewGID(GIDResourcePublication, 1)},
+	})
+
+	if GraphQLOperationName(query) != "publishableUnpublish" {
+		t.Errorf("Publication.Unpublish sent query %s", query)
+	}
+
+	expectedErr := "id: Product does not exist"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Publication.Unpublish returned error %v, expected %s", err, expectedErr)
+	}
+}
@@ -0,0 +1,141 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// RefundService is an interface for interfacing with the refund endpoints of
+// the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/refund
+type RefundService interface {
+	List(context.Context, uint64, interface{}) ([]Refund, error)
+	ListAll(context.Context, uint64, interface{}) ([]Refund, error)
+	ListWithPagination(context.Context, uint64, interface{}) ([]Refund, *Pagination, error)
+	Get(context.Context, uint64, uint64, interface{}) (*Refund, error)
+	Calculate(context.Context, uint64, Refund) (*Refund, error)
+	Create(context.Context, uint64, Refund) (*Refund, error)
+}
+
+// RefundServiceOp handles communication with the refund related methods of the
+// Shopify API.
+type RefundServiceOp struct {
+	client *Client
+}
+
+// RefundRestockType is how a refunded line item affects inventory
+type RefundRestockType string
+
+const (
+	// RefundRestockTypeNoRestock Refunding these items won't affect inventory.
+	RefundRestockTypeNoRestock RefundRestockType = "no_restock"
+
+	// RefundRestockTypeCancel The items have not yet been fulfilled. The canceled quantity will be added back to the available count.
+	RefundRestockTypeCancel RefundRestockType = "cancel"
+
+	// RefundRestockTypeReturn The items were already delivered but will be returned to the merchant. The returned quantity will be added back to the available count.
+	RefundRestockTypeReturn RefundRestockType = "return"
+
+	// RefundRestockTypeLegacyRestock The deprecated restock option for refunds created before 2019-04.
+	RefundRestockTypeLegacyRestock RefundRestockType = "legacy_restock"
+)
+
+// RefundShipping represents the shipping portion of a refund
+type RefundShipping struct {
+	FullRefund        bool             `json:"full_refund,omitempty"`
+	Amount            *decimal.Decimal `json:"amount,omitempty"`
+	Tax               *decimal.Decimal `json:"tax,omitempty"`
+	MaximumRefundable *decimal.Decimal `json:"maximum_refundable,omitempty"`
+}
+
+// RefundListOptions A struct for all available refund list options.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/refund#get-orders-order-id-refunds
+type RefundListOptions struct {
+	ListOptions
+	InShopCurrency bool `url:"in_shop_currency,omitempty"`
+}
+
+// RefundResource represents the result from the orders/X/refunds/Y.json endpoint
+type RefundResource struct {
+	Refund *Refund `json:"refund"`
+}
+
+// RefundsResource represents the result from the orders/X/refunds.json endpoint
+type RefundsResource struct {
+	Refunds []Refund `json:"refunds"`
+}
+
+// List refunds of an order
+func (s *RefundServiceOp) List(ctx context.Context, orderId uint64, options interface{}) ([]Refund, error) {
+	refunds, _, err := s.ListWithPagination(ctx, orderId, options)
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+// ListAll Lists all refunds of an order, iterating over pages
+func (s *RefundServiceOp) ListAll(ctx context.Context, orderId uint64, options interface{}) ([]Refund, error) {
+	collector := []Refund{}
+
+	for {
+		entities, pagination, err := s.ListWithPagination(ctx, orderId, options)
+
+		if err != nil {
+			return collector, err
+		}
+
+		collector = append(collector, entities...)
+
+		if pagination.NextPageOptions == nil {
+			break
+		}
+
+		options = pagination.NextPageOptions
+	}
+
+	return collector, nil
+}
+
+func (s *RefundServiceOp) ListWithPagination(ctx context.Context, orderId uint64, options interface{}) ([]Refund, *Pagination, error) {
+	path := fmt.Sprintf("%s/%d/refunds.json", ordersBasePath, orderId)
+	resource := new(RefundsResource)
+
+	pagination, err := s.client.ListWithPagination(ctx, path, resource, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resource.Refunds, pagination, nil
+}
+
+// Get individual refund
+func (s *RefundServiceOp) Get(ctx context.Context, orderId uint64, refundId uint64, options interface{}) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds/%d.json", ordersBasePath, orderId, refundId)
+	resource := new(RefundResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Refund, err
+}
+
+// Calculate calculates the refund for the given line items and shipping.
+// The returned refund contains suggested transactions with the parent id and
+// maximum refundable amount, which can be used as the transactions of Create.
+func (s *RefundServiceOp) Calculate(ctx context.Context, orderId uint64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds/calculate.json", ordersBasePath, orderId)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Refund, err
+}
+
+// Create creates a refund. Transactions must have their parent id set to the
+// transaction being refunded and kind set to "refund".
+func (s *RefundServiceOp) Create(ctx context.Context, orderId uint64, refund Refund) (*Refund, error) {
+	path := fmt.Sprintf("%s/%d/refunds.json", ordersBasePath, orderId)
+	wrappedData := RefundResource{Refund: &refund}
+	resource := new(RefundResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.Refund, err
+}
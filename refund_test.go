@@ -0,0 +1,208 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestRefundList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund/refunds.json")))
+
+	refunds, err := client.Refund.List(context.Background(), 450789469, nil)
+	if err != nil {
+		t.Errorf("Refund.List returned error: %v", err)
+	}
+
+	expected := []Refund{
+		{
+			Id:      509562969,
+			OrderId: 450789469,
+			Note:    "it broke during shipping",
+			RefundLineItems: []RefundLineItem{
+				{Id: 104689539, Quantity: 1, LineItemId: 703073504, RestockType: RefundRestockTypeNoRestock},
+			},
+		},
+		{Id: 509562970, OrderId: 450789469, Note: "wrong size"},
+	}
+	if !reflect.DeepEqual(refunds, expected) {
+		t.Errorf("Refund.List returned %+v, expected %+v", refunds, expected)
+	}
+}
+
+func TestRefundListWithPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		resp := httpmock.NewStringResponse(200, `{"refunds": [{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	refunds, pagination, err := client.Refund.ListWithPagination(context.Background(), 450789469, RefundListOptions{InShopCurrency: true})
+	if err != nil {
+		t.Errorf("Refund.ListWithPagination returned error: %v", err)
+	}
+
+	if len(refunds) != 2 {
+		t.Errorf("Refund.ListWithPagination returned %d refunds, expected 2", len(refunds))
+	}
+
+	expectedPagination := &Pagination{NextPageOptions: &ListOptions{PageInfo: "pg2", Limit: 2}}
+	if !reflect.DeepEqual(pagination, expectedPagination) {
+		t.Errorf("Refund.ListWithPagination returned pagination %+v, expected %+v", pagination, expectedPagination)
+	}
+}
+
+func TestRefundGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/509562969.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("refund/refund.json")))
+
+	refund, err := client.Refund.Get(context.Background(), 450789469, 509562969, nil)
+	if err != nil {
+		t.Errorf("Refund.Get returned error: %v", err)
+	}
+
+	if refund.Id != 509562969 || refund.AdminGraphqlApiId != "gid://shopify/Refund/509562969" {
+		t.Errorf("Refund.Get returned %+v", refund)
+	}
+
+	expectedLineItem := RefundLineItem{
+		Id:          104689539,
+		Quantity:    1,
+		LineItemId:  703073504,
+		LocationId:  487838322,
+		RestockType: RefundRestockTypeReturn,
+	}
+	lineItem := refund.RefundLineItems[0]
+	lineItem.Subtotal = nil
+	lineItem.TotalTax = nil
+	if !reflect.DeepEqual(lineItem, expectedLineItem) {
+		t.Errorf("Refund.Get returned line item %+v, expected %+v", lineItem, expectedLineItem)
+	}
+
+	var parentId int64 = 801038806
+	if refund.Transactions[0].ParentId == nil || *refund.Transactions[0].ParentId != parentId {
+		t.Errorf("Refund.Get returned transaction parent id %v, expected %d", refund.Transactions[0].ParentId, parentId)
+	}
+}
+
+func TestRefundCalculate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds/calculate.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(b, &body)
+			return httpmock.NewBytesResponse(200, loadFixture("refund/calculate.json")), nil
+		})
+
+	refund, err := client.Refund.Calculate(context.Background(), 450789469, Refund{
+		Currency: "USD",
+		Shipping: &RefundShipping{FullRefund: true},
+		RefundLineItems: []RefundLineItem{
+			{LineItemId: 518995019, Quantity: 1, RestockType: RefundRestockTypeReturn, LocationId: 487838322},
+		},
+	})
+	if err != nil {
+		t.Errorf("Refund.Calculate returned error: %v", err)
+	}
+
+	expectedBody := map[string]interface{}{
+		"refund": map[string]interface{}{
+			"currency": "USD",
+			"shipping": map[string]interface{}{"full_refund": true},
+			"refund_line_items": []interface{}{
+				map[string]interface{}{
+					"line_item_id": float64(518995019),
+					"quantity":     float64(1),
+					"restock_type": "return",
+					"location_id":  float64(487838322),
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(body, expectedBody) {
+		t.Errorf("Refund.Calculate sent %+v, expected %+v", body, expectedBody)
+	}
+
+	shippingMax := decimal.NewFromFloat(5)
+	if refund.Shipping == nil || !refund.Shipping.MaximumRefundable.Equal(shippingMax) {
+		t.Errorf("Refund.Calculate returned shipping %+v, expected maximum refundable %s", refund.Shipping, shippingMax)
+	}
+
+	transaction := refund.Transactions[0]
+	maxRefundable := decimal.NewFromFloat(204.65)
+	if transaction.Kind != "suggested_refund" || !transaction.MaximumRefundable.Equal(maxRefundable) || *transaction.ParentId != 801038806 {
+		t.Errorf("Refund.Calculate returned transaction %+v", transaction)
+	}
+}
+
+func TestRefundCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body map[string]interface{}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders/450789469/refunds.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			_ = json.Unmarshal(b, &body)
+			return httpmock.NewBytesResponse(201, loadFixture("refund/refund.json")), nil
+		})
+
+	amount := decimal.NewFromFloat(41.94)
+	var parentId int64 = 801038806
+	refund, err := client.Refund.Create(context.Background(), 450789469, Refund{
+		Currency: "USD",
+		Notify:   true,
+		Note:     "it broke during shipping",
+		Transactions: []Transaction{
+			{ParentId: &parentId, Amount: &amount, Kind: "refund", Gateway: "bogus"},
+		},
+	})
+	if err != nil {
+		t.Errorf("Refund.Create returned error: %v", err)
+	}
+
+	expectedBody := map[string]interface{}{
+		"refund": map[string]interface{}{
+			"currency": "USD",
+			"notify":   true,
+			"note":     "it broke during shipping",
+			"transactions": []interface{}{
+				map[string]interface{}{
+					"parent_id": float64(801038806),
+					"amount":    "41.94",
+					"kind":      "refund",
+					"gateway":   "bogus",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(body, expectedBody) {
+		t.Errorf("Refund.Create sent %+v, expected %+v", body, expectedBody)
+	}
+
+	if refund.Id != 509562969 {
+		t.Errorf("Refund.Create returned id %d, expected %d", refund.Id, 509562969)
+	}
+}
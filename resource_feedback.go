@@ -0,0 +1,98 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const resourceFeedbackBasePath = "resource_feedback"
+
+// ResourceFeedbackService is an interface for interfacing with the resource
+// feedback endpoints of the Shopify API. Sales channels use it to report
+// problems with the shop or its products to the merchant.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/resourcefeedback
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/product-resourcefeedback
+type ResourceFeedbackService interface {
+	List(context.Context) ([]ResourceFeedback, error)
+	Create(context.Context, ResourceFeedback) (*ResourceFeedback, error)
+	ListForProduct(context.Context, uint64) ([]ResourceFeedback, error)
+	CreateForProduct(context.Context, uint64, ResourceFeedback) (*ResourceFeedback, error)
+}
+
+// ResourceFeedbackServiceOp handles communication with the resource feedback
+// related methods of the Shopify API.
+type ResourceFeedbackServiceOp struct {
+	client *Client
+}
+
+type ResourceFeedbackState string
+
+const (
+	ResourceFeedbackStateRequiresAction ResourceFeedbackState = "requires_action"
+	ResourceFeedbackStateSuccess        ResourceFeedbackState = "success"
+)
+
+// ResourceFeedback represents the feedback of a sales channel on the shop or
+// one of its products. Messages are required when the state is
+// requires_action and must be empty when it is success.
+type ResourceFeedback struct {
+	ResourceId          uint64                `json:"resource_id,omitempty"`
+	ResourceType        string                `json:"resource_type,omitempty"`
+	State               ResourceFeedbackState `json:"state,omitempty"`
+	Messages            []string              `json:"messages"`
+	FeedbackGeneratedAt *time.Time            `json:"feedback_generated_at,omitempty"`
+	ResourceUpdatedAt   *time.Time            `json:"resource_updated_at,omitempty"`
+	CreatedAt           *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time            `json:"updated_at,omitempty"`
+}
+
+// ResourceFeedbackResource represents the result from creating resource
+// feedback
+type ResourceFeedbackResource struct {
+	ResourceFeedback *ResourceFeedback `json:"resource_feedback"`
+}
+
+// ResourceFeedbacksResource represents the result from the
+// resource_feedback.json endpoint, Shopify uses the same key for lists
+type ResourceFeedbacksResource struct {
+	ResourceFeedback []ResourceFeedback `json:"resource_feedback"`
+}
+
+// List the shop feedback of the sales channel
+func (s *ResourceFeedbackServiceOp) List(ctx context.Context) ([]ResourceFeedback, error) {
+	return s.list(ctx, fmt.Sprintf("%s.json", resourceFeedbackBasePath))
+}
+
+// Create shop feedback, e.g. to report that the shop needs to be configured
+// before it can sell on the sales channel
+func (s *ResourceFeedbackServiceOp) Create(ctx context.Context, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	return s.create(ctx, fmt.Sprintf("%s.json", resourceFeedbackBasePath), feedback)
+}
+
+// ListForProduct lists the feedback of the sales channel on a product
+func (s *ResourceFeedbackServiceOp) ListForProduct(ctx context.Context, productId uint64) ([]ResourceFeedback, error) {
+	return s.list(ctx, fmt.Sprintf("%s/%d/%s.json", productsBasePath, productId, resourceFeedbackBasePath))
+}
+
+// CreateForProduct creates feedback on a product, ResourceUpdatedAt should be
+// set to the updated_at of the product the feedback was generated for
+func (s *ResourceFeedbackServiceOp) CreateForProduct(ctx context.Context, productId uint64, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	return s.create(ctx, fmt.Sprintf("%s/%d/%s.json", productsBasePath, productId, resourceFeedbackBasePath), feedback)
+}
+
+func (s *ResourceFeedbackServiceOp) list(ctx context.Context, path string) ([]ResourceFeedback, error) {
+	resource := new(ResourceFeedbacksResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.ResourceFeedback, err
+}
+
+func (s *ResourceFeedbackServiceOp) create(ctx context.Context, path string, feedback ResourceFeedback) (*ResourceFeedback, error) {
+	if feedback.Messages == nil {
+		feedback.Messages = []string{}
+	}
+	wrappedData := ResourceFeedbackResource{ResourceFeedback: &feedback}
+	resource := new(ResourceFeedbackResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.ResourceFeedback, err
+}
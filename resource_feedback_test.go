@@ -0,0 +1,136 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func resourceFeedbackTests(t *testing.T, feedback ResourceFeedback) {
+	expectedResourceId := uint64(632910392)
+	if feedback.ResourceId != expectedResourceId {
+		t.Errorf("ResourceFeedback.ResourceId returned %+v, expected %+v", feedback.ResourceId, expectedResourceId)
+	}
+
+	expectedState := ResourceFeedbackStateRequiresAction
+	if feedback.State != expectedState {
+		t.Errorf("ResourceFeedback.State returned %+v, expected %+v", feedback.State, expectedState)
+	}
+
+	expectedMessages := []string{"Needs at least one image."}
+	if !reflect.DeepEqual(feedback.Messages, expectedMessages) {
+		t.Errorf("ResourceFeedback.Messages returned %+v, expected %+v", feedback.Messages, expectedMessages)
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	expectedResourceUpdatedAt := time.Date(2024, time.January, 1, 9, 0, 0, 0, location)
+	if feedback.ResourceUpdatedAt == nil || !expectedResourceUpdatedAt.Equal(*feedback.ResourceUpdatedAt) {
+		t.Errorf("ResourceFeedback.ResourceUpdatedAt returned %+v, expected %+v", feedback.ResourceUpdatedAt, expectedResourceUpdatedAt)
+	}
+}
+
+func TestResourceFeedbackList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/resource_feedback.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"resource_feedback":[{"resource_type":"Shop","state":"success","messages":[]}]}`))
+
+	feedback, err := client.ResourceFeedback.List(context.Background())
+	if err != nil {
+		t.Errorf("ResourceFeedback.List returned error: %v", err)
+	}
+
+	expected := []ResourceFeedback{{ResourceType: "Shop", State: ResourceFeedbackStateSuccess, Messages: []string{}}}
+	if !reflect.DeepEqual(feedback, expected) {
+		t.Errorf("ResourceFeedback.List returned %+v, expected %+v", feedback, expected)
+	}
+}
+
+func TestResourceFeedbackCreate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/resource_feedback.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(202, `{"resource_feedback":{"resource_type":"Shop","state":"success","messages":[]}}`), nil
+		})
+
+	feedback, err := client.ResourceFeedback.Create(context.Background(), ResourceFeedback{State: ResourceFeedbackStateSuccess})
+	if err != nil {
+		t.Fatalf("ResourceFeedback.Create returned error: %v", err)
+	}
+
+	expectedBody := `{"resource_feedback":{"state":"success","messages":[]}}`
+	if body != expectedBody {
+		t.Errorf("ResourceFeedback.Create sent %s, expected %s", body, expectedBody)
+	}
+
+	if feedback.ResourceType != "Shop" || feedback.State != ResourceFeedbackStateSuccess {
+		t.Errorf("ResourceFeedback.Create returned %+v", feedback)
+	}
+}
+
+func TestResourceFeedbackListForProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("resource_feedback.json")))
+
+	feedback, err := client.ResourceFeedback.ListForProduct(context.Background(), 632910392)
+	if err != nil {
+		t.Fatalf("ResourceFeedback.ListForProduct returned error: %v", err)
+	}
+
+	if len(feedback) != 1 {
+		t.Fatalf("ResourceFeedback.ListForProduct returned %d feedback, expected 1", len(feedback))
+	}
+
+	resourceFeedbackTests(t, feedback[0])
+}
+
+func TestResourceFeedbackCreateForProduct(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/632910392/resource_feedback.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			body = string(b)
+			return httpmock.NewStringResponse(202, `{"resource_feedback":{
+				"resource_id":632910392,
+				"resource_type":"Product",
+				"resource_updated_at":"2024-01-01T09:00:00-05:00",
+				"messages":["Needs at least one image."],
+				"state":"requires_action"
+			}}`), nil
+		})
+
+	resourceUpdatedAt := time.Date(2024, time.January, 1, 14, 0, 0, 0, time.UTC)
+	feedback, err := client.ResourceFeedback.CreateForProduct(context.Background(), 632910392, ResourceFeedback{
+		State:             ResourceFeedbackStateRequiresAction,
+		Messages:          []string{"Needs at least one image."},
+		ResourceUpdatedAt: &resourceUpdatedAt,
+	})
+	if err != nil {
+		t.Fatalf("ResourceFeedback.CreateForProduct returned error: %v", err)
+	}
+
+	expectedBody := `{"resource_feedback":{"state":"requires_action","messages":["Needs at least one image."],"resource_updated_at":"2024-01-01T14:00:00Z"}}`
+	if body != expectedBody {
+		t.Errorf("ResourceFeedback.CreateForProduct sent %s, expected %s", body, expectedBody)
+	}
+
+	resourceFeedbackTests(t, *feedback)
+}
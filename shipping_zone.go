@@ -11,6 +11,7 @@ import (
 // See: https://shopify.dev/docs/api/admin-rest/latest/resources/shippingzone
 type ShippingZoneService interface {
 	List(context.Context) ([]ShippingZone, error)
+	ListWithOptions(context.Context, interface{}) ([]ShippingZone, error)
 }
 
 // ShippingZoneServiceOp handles communication with the shipping zone related methods
@@ -86,14 +87,27 @@ type CarrierShippingRateProvider struct {
 	ServiceFilter    map[string]string `json:"service_filter,omitempty"`
 }
 
+// ShippingZoneListOptions A struct for all available shipping zone list options.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/shippingzone#get-shipping-zones
+type ShippingZoneListOptions struct {
+	Fields string `url:"fields,omitempty"`
+}
+
 // Represents the result from the shipping_zones.json endpoint
 type ShippingZonesResource struct {
 	ShippingZones []ShippingZone `json:"shipping_zones"`
 }
 
-// List shipping zones
+// List shipping zones. Shipping zones are read only through the REST API,
+// use the DeliveryProfileService to create and edit them.
 func (s *ShippingZoneServiceOp) List(ctx context.Context) ([]ShippingZone, error) {
+	return s.ListWithOptions(ctx, nil)
+}
+
+// ListWithOptions lists shipping zones with options, e.g.
+// ShippingZoneListOptions
+func (s *ShippingZoneServiceOp) ListWithOptions(ctx context.Context, options interface{}) ([]ShippingZone, error) {
 	resource := new(ShippingZonesResource)
-	err := s.client.Get(ctx, "shipping_zones.json", resource, nil)
+	err := s.client.Get(ctx, "shipping_zones.json", resource, options)
 	return resource.ShippingZones, err
 }
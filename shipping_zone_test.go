@@ -34,6 +34,14 @@ func shippingZoneTests(t *testing.T, zone ShippingZone) {
 		{"CarrierShippingRateProviders.0.CarrierServiceId", uint64(770241334), zone.CarrierShippingRateProviders[0].CarrierServiceId},
 		{"CarrierShippingRateProviders.0.FlatModifier", decimal.NewFromFloat(0).String(), zone.CarrierShippingRateProviders[0].FlatModifier.String()},
 		{"CarrierShippingRateProviders.0.PercentModifier", decimal.NewFromFloat(0).String(), zone.CarrierShippingRateProviders[0].PercentModifier.String()},
+		{"CarrierShippingRateProviders.0.ServiceFilter.*", "+", zone.CarrierShippingRateProviders[0].ServiceFilter["*"]},
+		{"Countries.0.Id", uint64(817138619), zone.Countries[0].Id},
+		{"Countries.0.Code", "US", zone.Countries[0].Code},
+		{"Countries.0.TaxName", "Federal Tax", zone.Countries[0].TaxName},
+		{"Countries.0.Provinces.1.Code", "OH", zone.Countries[0].Provinces[1].Code},
+		{"Countries.0.Provinces.1.CountryId", uint64(817138619), zone.Countries[0].Provinces[1].CountryId},
+		{"Countries.0.Provinces.1.TaxType", "", zone.Countries[0].Provinces[1].TaxType},
+		{"Countries.0.Provinces.1.TaxPercentage", decimal.NewFromFloat(0).String(), zone.Countries[0].Provinces[1].TaxPercentage.String()},
 	}
 
 	for _, c := range cases {
@@ -83,3 +91,22 @@ func TestShippingZoneList(t *testing.T) {
 	zone := shippingZones[0]
 	shippingZoneTests(t, zone)
 }
+
+func TestShippingZoneListWithOptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"fields": "id,name"}
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shipping_zones.json", client.pathPrefix),
+		params,
+		httpmock.NewStringResponder(200, `{"shipping_zones": [{"id":1,"name":"Domestic"}]}`))
+
+	shippingZones, err := client.ShippingZone.ListWithOptions(context.Background(), ShippingZoneListOptions{Fields: "id,name"})
+	if err != nil {
+		t.Errorf("ShippingZone.ListWithOptions returned error: %v", err)
+	}
+
+	if len(shippingZones) != 1 || shippingZones[0].Id != 1 || shippingZones[0].Name != "Domestic" {
+		t.Errorf("ShippingZone.ListWithOptions returned %+v", shippingZones)
+	}
+}
@@ -0,0 +1,517 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultStorefrontApiPathPrefix = "api"
+
+	storefrontAccessTokenHeader = "X-Shopify-Storefront-Access-Token"
+	storefrontBuyerIPHeader     = "Shopify-Storefront-Buyer-IP"
+)
+
+// StorefrontClient manages communication with the Shopify Storefront API.
+// It is authenticated with a storefront access token, see
+// StorefrontAccessTokenService.Create.
+// See: https://shopify.dev/docs/api/storefront
+type StorefrontClient struct {
+	client  *Client
+	graphql *GraphQLServiceOp
+
+	// A storefront access token
+	token string
+
+	// Services used for communicating with the API
+	Product StorefrontProductService
+	Cart    StorefrontCartService
+}
+
+type storefrontBuyerIPKey struct{}
+
+// ContextWithStorefrontBuyerIP returns a context that forwards the given buyer
+// ip to the Storefront API with every request made with it. This should be
+// set when making requests on behalf of a buyer from a server.
+func ContextWithStorefrontBuyerIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, storefrontBuyerIPKey{}, ip)
+}
+
+// NewStorefrontClient returns a new Shopify Storefront API client with a
+// storefront access token. The shopName parameter is the shop's myshopify
+// domain, e.g. "theshop.myshopify.com", or simply "theshop".
+// The same options as for NewClient can be used, e.g. WithVersion, WithRetry
+// and WithHTTPClient.
+func NewStorefrontClient(shopName, token string, opts ...Option) (*StorefrontClient, error) {
+	c, err := NewClient(App{}, shopName, "", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.pathPrefix = defaultStorefrontApiPathPrefix
+	if apiVersionRegex.MatchString(c.apiVersion) || c.apiVersion == UnstableApiVersion {
+		c.pathPrefix = fmt.Sprintf("%s/%s", defaultStorefrontApiPathPrefix, c.apiVersion)
+	}
+
+	sc := &StorefrontClient{
+		client:  c,
+		graphql: &GraphQLServiceOp{client: c},
+		token:   token,
+	}
+
+	sc.Product = &StorefrontProductServiceOp{client: sc}
+	sc.Cart = &StorefrontCartServiceOp{client: sc}
+
+	return sc, nil
+}
+
+// MustNewStorefrontClient returns a new Shopify Storefront API client,
+// panics if an error occurs
+func MustNewStorefrontClient(shopName, token string, opts ...Option) *StorefrontClient {
+	c, err := NewStorefrontClient(shopName, token, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Query creates a graphql query against the Shopify Storefront API
+// the "data" portion of the response is unmarshalled into resp
+func (c *StorefrontClient) Query(ctx context.Context, q string, vars, resp interface{}) error {
+	header := http.Header{}
+	header.Set(storefrontAccessTokenHeader, c.token)
+
+	if ip, ok := ctx.Value(storefrontBuyerIPKey{}).(string); ok && ip != "" {
+		header.Set(storefrontBuyerIPHeader, ip)
+	}
+
+	_, err := c.graphql.query(ctx, q, vars, resp, header)
+	return err
+}
+
+// StorefrontAttribute represents a custom key value pair
+type StorefrontAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StorefrontProductService is an interface for querying products through the
+// Storefront API.
+// See: https://shopify.dev/docs/api/storefront/latest/objects/Product
+type StorefrontProductService interface {
+	Get(context.Context, GID) (*StorefrontProduct, error)
+	GetByHandle(context.Context, string) (*StorefrontProduct, error)
+	List(context.Context, StorefrontProductListOptions) ([]StorefrontProduct, *GraphQLPageInfo, error)
+}
+
+// StorefrontProductServiceOp handles communication with the product related
+// methods of the Storefront API.
+type StorefrontProductServiceOp struct {
+	client *StorefrontClient
+}
+
+// storefrontProductPageSize is the default number of products requested per
+// page
+const storefrontProductPageSize = 50
+
+// StorefrontProductListOptions represents the options of a product listing,
+// First defaults to 50 products
+type StorefrontProductListOptions struct {
+	First int    `json:"first"`
+	After string `json:"after,omitempty"`
+	Query string `json:"query,omitempty"`
+}
+
+// StorefrontProduct represents a product in the Storefront API
+type StorefrontProduct struct {
+	Id               GID                        `json:"id"`
+	Handle           string                     `json:"handle,omitempty"`
+	Title            string                     `json:"title,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	DescriptionHtml  string                     `json:"descriptionHtml,omitempty"`
+	Vendor           string                     `json:"vendor,omitempty"`
+	ProductType      string                     `json:"productType,omitempty"`
+	Tags             []string                   `json:"tags,omitempty"`
+	AvailableForSale bool                       `json:"availableForSale"`
+	PriceRange       *StorefrontPriceRange      `json:"priceRange,omitempty"`
+	Variants         []StorefrontProductVariant `json:"variants,omitempty"`
+	CreatedAt        *time.Time                 `json:"createdAt,omitempty"`
+	UpdatedAt        *time.Time                 `json:"updatedAt,omitempty"`
+}
+
+// StorefrontPriceRange represents the price range of a product
+type StorefrontPriceRange struct {
+	MinVariantPrice *MoneyV2 `json:"minVariantPrice,omitempty"`
+	MaxVariantPrice *MoneyV2 `json:"maxVariantPrice,omitempty"`
+}
+
+// StorefrontProductVariant represents a product variant in the Storefront API
+type StorefrontProductVariant struct {
+	Id               GID                        `json:"id"`
+	Title            string                     `json:"title,omitempty"`
+	Sku              string                     `json:"sku,omitempty"`
+	AvailableForSale bool                       `json:"availableForSale"`
+	Price            *MoneyV2                   `json:"price,omitempty"`
+	CompareAtPrice   *MoneyV2                   `json:"compareAtPrice,omitempty"`
+	SelectedOptions  []StorefrontSelectedOption `json:"selectedOptions,omitempty"`
+	Product          *StorefrontProduct         `json:"product,omitempty"`
+}
+
+// StorefrontSelectedOption represents an option value of a product variant
+type StorefrontSelectedOption struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// storefrontProduct is used to unmarshal a product with its variants
+// connection
+type storefrontProduct struct {
+	StorefrontProduct
+	Variants struct {
+		Nodes []StorefrontProductVariant `json:"nodes"`
+	} `json:"variants"`
+}
+
+func (p *storefrontProduct) toProduct() *StorefrontProduct {
+	product := p.StorefrontProduct
+	product.Variants = p.Variants.Nodes
+	return &product
+}
+
+const storefrontProductFields = `
+  id
+  handle
+  title
+  description
+  descriptionHtml
+  vendor
+  productType
+  tags
+  availableForSale
+  createdAt
+  updatedAt
+  priceRange {
+    minVariantPrice { amount currencyCode }
+    maxVariantPrice { amount currencyCode }
+  }
+  variants(first: 100) {
+    nodes {
+      id
+      title
+      sku
+      availableForSale
+      price { amount currencyCode }
+      compareAtPrice { amount currencyCode }
+      selectedOptions { name value }
+    }
+  }
+`
+
+const storefrontProductQuery = `query product($id: ID, $handle: String) {
+  product(id: $id, handle: $handle) {` + storefrontProductFields + `}
+}`
+
+const storefrontProductsQuery = `query products($first: Int!, $after: String, $query: String) {
+  products(first: $first, after: $after, query: $query) {
+    pageInfo {
+      hasNextPage
+      hasPreviousPage
+      startCursor
+      endCursor
+    }
+    nodes {` + storefrontProductFields + `}
+  }
+}`
+
+// Get retrieves a product by id
+func (s *StorefrontProductServiceOp) Get(ctx context.Context, id GID) (*StorefrontProduct, error) {
+	return s.get(ctx, map[string]interface{}{"id": id})
+}
+
+// GetByHandle retrieves a product by handle
+func (s *StorefrontProductServiceOp) GetByHandle(ctx context.Context, handle string) (*StorefrontProduct, error) {
+	return s.get(ctx, map[string]interface{}{"handle": handle})
+}
+
+func (s *StorefrontProductServiceOp) get(ctx context.Context, vars map[string]interface{}) (*StorefrontProduct, error) {
+	resp := struct {
+		Product *storefrontProduct `json:"product"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontProductQuery, vars, &resp)
+	if err != nil || resp.Product == nil {
+		return nil, err
+	}
+
+	return resp.Product.toProduct(), nil
+}
+
+// List retrieves a page of products, the returned page info can be used to
+// request the next page
+func (s *StorefrontProductServiceOp) List(ctx context.Context, options StorefrontProductListOptions) ([]StorefrontProduct, *GraphQLPageInfo, error) {
+	if options.First == 0 {
+		options.First = storefrontProductPageSize
+	}
+
+	resp := struct {
+		Products struct {
+			PageInfo GraphQLPageInfo     `json:"pageInfo"`
+			Nodes    []storefrontProduct `json:"nodes"`
+		} `json:"products"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontProductsQuery, options, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products := make([]StorefrontProduct, 0, len(resp.Products.Nodes))
+	for i := range resp.Products.Nodes {
+		products = append(products, *resp.Products.Nodes[i].toProduct())
+	}
+
+	return products, &resp.Products.PageInfo, nil
+}
+
+// StorefrontCartService is an interface for managing carts through the
+// Storefront API.
+// See: https://shopify.dev/docs/api/storefront/latest/objects/Cart
+type StorefrontCartService interface {
+	Get(context.Context, string) (*StorefrontCart, error)
+	Create(context.Context, StorefrontCartInput) (*StorefrontCart, error)
+	AddLines(context.Context, string, []StorefrontCartLineInput) (*StorefrontCart, error)
+	UpdateLines(context.Context, string, []StorefrontCartLineUpdateInput) (*StorefrontCart, error)
+	RemoveLines(context.Context, string, []string) (*StorefrontCart, error)
+}
+
+// StorefrontCartServiceOp handles communication with the cart related methods
+// of the Storefront API.
+type StorefrontCartServiceOp struct {
+	client *StorefrontClient
+}
+
+// StorefrontCart represents a cart in the Storefront API. Cart and cart line
+// ids are not numeric and are therefore kept as strings.
+type StorefrontCart struct {
+	Id            string                       `json:"id"`
+	CheckoutUrl   string                       `json:"checkoutUrl,omitempty"`
+	Note          string                       `json:"note,omitempty"`
+	TotalQuantity int                          `json:"totalQuantity"`
+	Attributes    []StorefrontAttribute        `json:"attributes,omitempty"`
+	BuyerIdentity *StorefrontCartBuyerIdentity `json:"buyerIdentity,omitempty"`
+	Cost          *StorefrontCartCost          `json:"cost,omitempty"`
+	Lines         []StorefrontCartLine         `json:"lines,omitempty"`
+	CreatedAt     *time.Time                   `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time                   `json:"updatedAt,omitempty"`
+}
+
+// StorefrontCartCost represents the estimated costs of a cart
+type StorefrontCartCost struct {
+	SubtotalAmount *MoneyV2 `json:"subtotalAmount,omitempty"`
+	TotalAmount    *MoneyV2 `json:"totalAmount,omitempty"`
+	TotalTaxAmount *MoneyV2 `json:"totalTaxAmount,omitempty"`
+}
+
+// StorefrontCartBuyerIdentity represents the buyer of a cart
+type StorefrontCartBuyerIdentity struct {
+	Email       string `json:"email,omitempty"`
+	Phone       string `json:"phone,omitempty"`
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+// StorefrontCartLine represents a line of a cart
+type StorefrontCartLine struct {
+	Id          string                    `json:"id"`
+	Quantity    int                       `json:"quantity"`
+	Merchandise *StorefrontProductVariant `json:"merchandise,omitempty"`
+	Attributes  []StorefrontAttribute     `json:"attributes,omitempty"`
+	Cost        *StorefrontCartLineCost   `json:"cost,omitempty"`
+}
+
+// StorefrontCartLineCost represents the estimated costs of a cart line
+type StorefrontCartLineCost struct {
+	AmountPerQuantity *MoneyV2 `json:"amountPerQuantity,omitempty"`
+	TotalAmount       *MoneyV2 `json:"totalAmount,omitempty"`
+}
+
+// StorefrontCartInput represents the input of the cartCreate mutation
+type StorefrontCartInput struct {
+	Lines         []StorefrontCartLineInput    `json:"lines,omitempty"`
+	Note          string                       `json:"note,omitempty"`
+	Attributes    []StorefrontAttribute        `json:"attributes,omitempty"`
+	BuyerIdentity *StorefrontCartBuyerIdentity `json:"buyerIdentity,omitempty"`
+}
+
+// StorefrontCartLineInput represents a line to add to a cart
+type StorefrontCartLineInput struct {
+	MerchandiseId GID                   `json:"merchandiseId"`
+	Quantity      int                   `json:"quantity,omitempty"`
+	Attributes    []StorefrontAttribute `json:"attributes,omitempty"`
+}
+
+// StorefrontCartLineUpdateInput represents a change to an existing cart line
+type StorefrontCartLineUpdateInput struct {
+	Id            string                `json:"id"`
+	Quantity      *int                  `json:"quantity,omitempty"`
+	MerchandiseId *GID                  `json:"merchandiseId,omitempty"`
+	Attributes    []StorefrontAttribute `json:"attributes,omitempty"`
+}
+
+// storefrontCart is used to unmarshal a cart with its lines connection
+type storefrontCart struct {
+	StorefrontCart
+	Lines struct {
+		Nodes []StorefrontCartLine `json:"nodes"`
+	} `json:"lines"`
+}
+
+func (c *storefrontCart) toCart() *StorefrontCart {
+	if c == nil {
+		return nil
+	}
+	cart := c.StorefrontCart
+	cart.Lines = c.Lines.Nodes
+	return &cart
+}
+
+// storefrontCartPayload represents the payload of the cart mutations
+type storefrontCartPayload struct {
+	Cart       *storefrontCart    `json:"cart"`
+	UserErrors []GraphQLUserError `json:"userErrors"`
+}
+
+const storefrontCartFields = `
+  id
+  checkoutUrl
+  note
+  totalQuantity
+  createdAt
+  updatedAt
+  attributes { key value }
+  buyerIdentity { email phone countryCode }
+  cost {
+    subtotalAmount { amount currencyCode }
+    totalAmount { amount currencyCode }
+    totalTaxAmount { amount currencyCode }
+  }
+  lines(first: 250) {
+    nodes {
+      id
+      quantity
+      attributes { key value }
+      cost {
+        amountPerQuantity { amount currencyCode }
+        totalAmount { amount currencyCode }
+      }
+      merchandise {
+        ... on ProductVariant {
+          id
+          title
+          sku
+          price { amount currencyCode }
+          product { id handle title }
+        }
+      }
+    }
+  }
+`
+
+const storefrontCartQuery = `query cart($id: ID!) {
+  cart(id: $id) {` + storefrontCartFields + `}
+}`
+
+const storefrontCartCreateMutation = `mutation cartCreate($input: CartInput) {
+  cartCreate(input: $input) {
+    cart {` + storefrontCartFields + `}
+    userErrors { field message code }
+  }
+}`
+
+const storefrontCartLinesAddMutation = `mutation cartLinesAdd($cartId: ID!, $lines: [CartLineInput!]!) {
+  cartLinesAdd(cartId: $cartId, lines: $lines) {
+    cart {` + storefrontCartFields + `}
+    userErrors { field message code }
+  }
+}`
+
+const storefrontCartLinesUpdateMutation = `mutation cartLinesUpdate($cartId: ID!, $lines: [CartLineUpdateInput!]!) {
+  cartLinesUpdate(cartId: $cartId, lines: $lines) {
+    cart {` + storefrontCartFields + `}
+    userErrors { field message code }
+  }
+}`
+
+const storefrontCartLinesRemoveMutation = `mutation cartLinesRemove($cartId: ID!, $lineIds: [ID!]!) {
+  cartLinesRemove(cartId: $cartId, lineIds: $lineIds) {
+    cart {` + storefrontCartFields + `}
+    userErrors { field message code }
+  }
+}`
+
+// Get retrieves a cart
+func (s *StorefrontCartServiceOp) Get(ctx context.Context, cartId string) (*StorefrontCart, error) {
+	resp := struct {
+		Cart *storefrontCart `json:"cart"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontCartQuery, map[string]interface{}{"id": cartId}, &resp)
+	return resp.Cart.toCart(), err
+}
+
+// Create creates a cart
+func (s *StorefrontCartServiceOp) Create(ctx context.Context, input StorefrontCartInput) (*StorefrontCart, error) {
+	vars := map[string]interface{}{"input": input}
+	resp := struct {
+		CartCreate storefrontCartPayload `json:"cartCreate"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontCartCreateMutation, vars, &resp)
+	return s.payloadCart(resp.CartCreate, err)
+}
+
+// AddLines adds lines to a cart
+func (s *StorefrontCartServiceOp) AddLines(ctx context.Context, cartId string, lines []StorefrontCartLineInput) (*StorefrontCart, error) {
+	vars := map[string]interface{}{"cartId": cartId, "lines": lines}
+	resp := struct {
+		CartLinesAdd storefrontCartPayload `json:"cartLinesAdd"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontCartLinesAddMutation, vars, &resp)
+	return s.payloadCart(resp.CartLinesAdd, err)
+}
+
+// UpdateLines updates the quantity, merchandise or attributes of cart lines
+func (s *StorefrontCartServiceOp) UpdateLines(ctx context.Context, cartId string, lines []StorefrontCartLineUpdateInput) (*StorefrontCart, error) {
+	vars := map[string]interface{}{"cartId": cartId, "lines": lines}
+	resp := struct {
+		CartLinesUpdate storefrontCartPayload `json:"cartLinesUpdate"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontCartLinesUpdateMutation, vars, &resp)
+	return s.payloadCart(resp.CartLinesUpdate, err)
+}
+
+// RemoveLines removes lines from a cart
+func (s *StorefrontCartServiceOp) RemoveLines(ctx context.Context, cartId string, lineIds []string) (*StorefrontCart, error) {
+	vars := map[string]interface{}{"cartId": cartId, "lineIds": lineIds}
+	resp := struct {
+		CartLinesRemove storefrontCartPayload `json:"cartLinesRemove"`
+	}{}
+
+	err := s.client.Query(ctx, storefrontCartLinesRemoveMutation, vars, &resp)
+	return s.payloadCart(resp.CartLinesRemove, err)
+}
+
+func (s *StorefrontCartServiceOp) payloadCart(payload storefrontCartPayload, err error) (*StorefrontCart, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(payload.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return payload.Cart.toCart(), nil
+}
@@ -0,0 +1,276 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+var storefrontClient *StorefrontClient
+
+func storefrontSetup() {
+	storefrontClient = MustNewStorefrontClient("fooshop", "sf-token",
+		WithVersion(testApiVersion),
+		WithRetry(maxRetries))
+	httpmock.ActivateNonDefault(storefrontClient.client.Client)
+}
+
+func TestNewStorefrontClient(t *testing.T) {
+	cases := []struct {
+		opts     []Option
+		expected string
+	}{
+		{nil, "api"},
+		{[]Option{WithVersion(testApiVersion)}, "api/" + testApiVersion},
+		{[]Option{WithVersion(UnstableApiVersion)}, "api/unstable"},
+		{[]Option{WithVersion("invalid")}, "api"},
+	}
+
+	for _, c := range cases {
+		sc := MustNewStorefrontClient("fooshop", "sf-token", c.opts...)
+		if sc.client.pathPrefix != c.expected {
+			t.Errorf("NewStorefrontClient pathPrefix = %s, expected %s", sc.client.pathPrefix, c.expected)
+		}
+	}
+}
+
+func TestStorefrontQueryHeaders(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	var header http.Header
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		func(req *http.Request) (*http.Response, error) {
+			header = req.Header
+			return httpmock.NewStringResponse(200, `{"data":{"shop":{"name":"foo"}}}`), nil
+		},
+	)
+
+	resp := struct{}{}
+	ctx := ContextWithStorefrontBuyerIP(context.Background(), "192.0.2.1")
+	err := storefrontClient.Query(ctx, "query { shop { name } }", nil, &resp)
+	if err != nil {
+		t.Fatalf("StorefrontClient.Query returned error: %v", err)
+	}
+
+	if header.Get("X-Shopify-Storefront-Access-Token") != "sf-token" {
+		t.Errorf("StorefrontClient.Query sent storefront token %q", header.Get("X-Shopify-Storefront-Access-Token"))
+	}
+
+	if header.Get("Shopify-Storefront-Buyer-IP") != "192.0.2.1" {
+		t.Errorf("StorefrontClient.Query sent buyer ip %q", header.Get("Shopify-Storefront-Buyer-IP"))
+	}
+
+	if header.Get("X-Shopify-Access-Token") != "" || header.Get("Authorization") != "" {
+		t.Error("StorefrontClient.Query should not send admin credentials")
+	}
+}
+
+func TestStorefrontProductGetByHandle(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		httpmock.NewStringResponder(200, `{"data":{"product":{
+			"id":"gid://shopify/Product/1",
+			"handle":"ipod",
+			"title":"IPod",
+			"availableForSale":true,
+			"priceRange":{"minVariantPrice":{"amount":"199.0","currencyCode":"USD"}},
+			"variants":{"nodes":[{
+				"id":"gid://shopify/ProductVariant/2",
+				"title":"Pink",
+				"availableForSale":true,
+				"price":{"amount":"199.0","currencyCode":"USD"},
+				"selectedOptions":[{"name":"Color","value":"Pink"}]
+			}]}
+		}}}`),
+	)
+
+	product, err := storefrontClient.Product.GetByHandle(context.Background(), "ipod")
+	if err != nil {
+		t.Fatalf("Storefront.Product.GetByHandle returned error: %v", err)
+	}
+
+	price := decimal.NewFromFloat(199)
+	expected := &StorefrontProduct{
+		Id:               NewGID(GIDResourceProduct, 1),
+		Handle:           "ipod",
+		Title:            "IPod",
+		AvailableForSale: true,
+		PriceRange: &StorefrontPriceRange{
+			MinVariantPrice: &MoneyV2{Amount: &price, CurrencyCode: "USD"},
+		},
+		Variants: []StorefrontProductVariant{{
+			Id:               NewGID(GIDResourceProductVariant, 2),
+			Title:            "Pink",
+			AvailableForSale: true,
+			Price:            &MoneyV2{Amount: &price, CurrencyCode: "USD"},
+			SelectedOptions:  []StorefrontSelectedOption{{Name: "Color", Value: "Pink"}},
+		}},
+	}
+
+	if product.Id.String() != expected.Id.String() || product.Handle != expected.Handle || len(product.Variants) != 1 {
+		t.Fatalf("Storefront.Product.GetByHandle returned %+v, expected %+v", product, expected)
+	}
+
+	if !product.PriceRange.MinVariantPrice.Amount.Equal(price) {
+		t.Errorf("Storefront.Product.GetByHandle min price %s, expected %s", product.PriceRange.MinVariantPrice.Amount, price)
+	}
+
+	if !reflect.DeepEqual(product.Variants[0].SelectedOptions, expected.Variants[0].SelectedOptions) {
+		t.Errorf("Storefront.Product.GetByHandle variant options %+v, expected %+v", product.Variants[0].SelectedOptions, expected.Variants[0].SelectedOptions)
+	}
+}
+
+func TestStorefrontProductList(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	var body string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			body = string(vars["after"])
+			return httpmock.NewStringResponse(200, `{"data":{"products":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+				"nodes":[{"id":"gid://shopify/Product/1","variants":{"nodes":[]}},{"id":"gid://shopify/Product/2","variants":{"nodes":[]}}]
+			}}}`), nil
+		},
+	)
+
+	products, pageInfo, err := storefrontClient.Product.List(context.Background(), StorefrontProductListOptions{First: 2, After: "xyz"})
+	if err != nil {
+		t.Fatalf("Storefront.Product.List returned error: %v", err)
+	}
+
+	if body != `"xyz"` {
+		t.Errorf("Storefront.Product.List sent after %s, expected %s", body, `"xyz"`)
+	}
+
+	if len(products) != 2 || products[1].Id.Id != 2 {
+		t.Errorf("Storefront.Product.List returned %+v", products)
+	}
+
+	expectedPageInfo := &GraphQLPageInfo{HasNextPage: true, EndCursor: "abc"}
+	if !reflect.DeepEqual(pageInfo, expectedPageInfo) {
+		t.Errorf("Storefront.Product.List returned page info %+v, expected %+v", pageInfo, expectedPageInfo)
+	}
+}
+
+func TestStorefrontProductListDefaultFirst(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	var first string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			first = string(vars["first"])
+			return httpmock.NewStringResponse(200, `{"data":{"products":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[]
+			}}}`), nil
+		},
+	)
+
+	_, _, err := storefrontClient.Product.List(context.Background(), StorefrontProductListOptions{})
+	if err != nil {
+		t.Fatalf("Storefront.Product.List returned error: %v", err)
+	}
+
+	if first != "50" {
+		t.Errorf("Storefront.Product.List sent first %s, expected %s", first, "50")
+	}
+}
+
+func TestStorefrontCartCreate(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	var input string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			input = string(vars["input"])
+			return httpmock.NewStringResponse(200, `{"data":{"cartCreate":{
+				"cart":{
+					"id":"gid://shopify/Cart/c1-abc?key=123",
+					"checkoutUrl":"https://fooshop.myshopify.com/cart/c/c1-abc",
+					"totalQuantity":2,
+					"lines":{"nodes":[{
+						"id":"gid://shopify/CartLine/1a2b?cart=c1-abc",
+						"quantity":2,
+						"merchandise":{"id":"gid://shopify/ProductVariant/2","title":"Pink"}
+					}]}
+				},
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	cart, err := storefrontClient.Cart.Create(context.Background(), StorefrontCartInput{
+		Lines: []StorefrontCartLineInput{{MerchandiseId: NewGID(GIDResourceProductVariant, 2), Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("Storefront.Cart.Create returned error: %v", err)
+	}
+
+	expectedInput := `{"lines":[{"merchandiseId":"gid://shopify/ProductVariant/2","quantity":2}]}`
+	if input != expectedInput {
+		t.Errorf("Storefront.Cart.Create sent input %s, expected %s", input, expectedInput)
+	}
+
+	if cart.Id != "gid://shopify/Cart/c1-abc?key=123" || cart.TotalQuantity != 2 || len(cart.Lines) != 1 {
+		t.Fatalf("Storefront.Cart.Create returned %+v", cart)
+	}
+
+	if cart.Lines[0].Merchandise.Id.Id != 2 {
+		t.Errorf("Storefront.Cart.Create returned line %+v", cart.Lines[0])
+	}
+}
+
+func TestStorefrontCartLinesAddUserErrors(t *testing.T) {
+	storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/api/%s/graphql.json", testApiVersion),
+		func(req *http.Request) (*http.Response, error) {
+			q, _ := graphQLRequestBody(req)
+			if !strings.Contains(q, "cartLinesAdd(") {
+				return httpmock.NewStringResponse(400, ""), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"cartLinesAdd":{
+				"cart":null,
+				"userErrors":[{"field":["lines","0","merchandiseId"],"message":"The merchandise does not exist.","code":"INVALID"}]
+			}}}`), nil
+		},
+	)
+
+	_, err := storefrontClient.Cart.AddLines(context.Background(), "gid://shopify/Cart/c1", []StorefrontCartLineInput{
+		{MerchandiseId: NewGID(GIDResourceProductVariant, 9), Quantity: 1},
+	})
+
+	expected := "lines.0.merchandiseId: The merchandise does not exist."
+	if err == nil || err.Error() != expected {
+		t.Errorf("Storefront.Cart.AddLines returned error %v, expected %s", err, expected)
+	}
+}
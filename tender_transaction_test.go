@@ -0,0 +1,95 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+func TestTenderTransactionList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	params := map[string]string{"processed_at_min": "2024-02-01T00:00:00Z", "order": "processed_at ASC"}
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix),
+		params,
+		httpmock.NewBytesResponder(200, loadFixture("tender_transactions.json")))
+
+	processedAtMin := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	tenderTransactions, err := client.TenderTransaction.List(context.Background(), TenderTransactionListOptions{
+		ProcessedAtMin: &processedAtMin,
+		Order:          "processed_at ASC",
+	})
+	if err != nil {
+		t.Fatalf("TenderTransaction.List returned error: %v", err)
+	}
+
+	if len(tenderTransactions) != 2 {
+		t.Fatalf("TenderTransaction.List returned %d tender transactions, expected 2", len(tenderTransactions))
+	}
+
+	location, _ := time.LoadLocation("America/New_York")
+	processedAt := time.Date(2024, time.February, 28, 9, 0, 0, 0, location)
+	amount := decimal.RequireFromString("250.94")
+	expected := TenderTransaction{
+		Id:              1011222740,
+		OrderId:         450789469,
+		Amount:          &amount,
+		Currency:        "USD",
+		ProcessedAt:     &processedAt,
+		RemoteReference: "authorization-key",
+		PaymentMethod:   "credit_card",
+		PaymentDetails: &TenderTransactionPaymentDetails{
+			CreditCardNumber:  "•••• •••• •••• 4242",
+			CreditCardCompany: "Visa",
+		},
+	}
+
+	actual := tenderTransactions[0]
+	if !actual.ProcessedAt.Equal(*expected.ProcessedAt) {
+		t.Errorf("TenderTransaction.ProcessedAt returned %+v, expected %+v", actual.ProcessedAt, expected.ProcessedAt)
+	}
+	actual.ProcessedAt = expected.ProcessedAt
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("TenderTransaction.List returned %+v, expected %+v", actual, expected)
+	}
+
+	refund := decimal.NewFromFloat(-10)
+	if tenderTransactions[1].Amount == nil || !tenderTransactions[1].Amount.Equal(refund) || tenderTransactions[1].PaymentDetails != nil {
+		t.Errorf("TenderTransaction.List returned %+v", tenderTransactions[1])
+	}
+}
+
+func TestTenderTransactionListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/tender_transactions.json", client.pathPrefix)
+
+	httpmock.RegisterResponder("GET", listURL, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("page_info") == "pg2" {
+			return httpmock.NewStringResponse(200, `{"tender_transactions": [{"id":3}]}`), nil
+		}
+		resp := httpmock.NewStringResponse(200, `{"tender_transactions": [{"id":1},{"id":2}]}`)
+		resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=pg2&limit=2>; rel="next"`, listURL))
+		return resp, nil
+	})
+
+	tenderTransactions, err := client.TenderTransaction.ListAll(context.Background(), nil)
+	if err != nil {
+		t.Errorf("TenderTransaction.ListAll returned error: %v", err)
+	}
+
+	expected := []TenderTransaction{{Id: 1}, {Id: 2}, {Id: 3}}
+	if !reflect.DeepEqual(tenderTransactions, expected) {
+		t.Errorf("TenderTransaction.ListAll returned %+v, expected %+v", tenderTransactions, expected)
+	}
+}
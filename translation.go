@@ -0,0 +1,472 @@
+package goshopify
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// translatableResourcePageSize is the default number of translatable
+// resources requested per page
+const translatableResourcePageSize = 50
+
+// TranslationService is an interface for managing translations and shop
+// locales through the graphql endpoint of the Shopify API.
+// See: https://shopify.dev/docs/apps/markets/translate-content
+type TranslationService interface {
+	ListResources(context.Context, TranslatableResourceType, *TranslatableResourceListOptions) ([]TranslatableResource, *GraphQLPageInfo, error)
+	ListAllResources(context.Context, TranslatableResourceType, string) ([]TranslatableResource, error)
+	EachResource(context.Context, TranslatableResourceType, string, func(TranslatableResource) error) error
+	GetResource(context.Context, GID, string) (*TranslatableResource, error)
+	Register(context.Context, GID, []TranslationInput) ([]Translation, error)
+	Remove(context.Context, GID, []string, []string) ([]Translation, error)
+	ListLocales(context.Context) ([]ShopLocale, error)
+	EnableLocale(context.Context, string) (*ShopLocale, error)
+}
+
+// TranslationServiceOp handles communication with the translation related
+// methods of the Shopify API.
+type TranslationServiceOp struct {
+	client *Client
+}
+
+// TranslatableResourceType is the type of resource a translation applies to
+type TranslatableResourceType string
+
+const (
+	TranslatableResourceTypeCollection         TranslatableResourceType = "COLLECTION"
+	TranslatableResourceTypeDeliveryMethod     TranslatableResourceType = "DELIVERY_METHOD_DEFINITION"
+	TranslatableResourceTypeEmailTemplate      TranslatableResourceType = "EMAIL_TEMPLATE"
+	TranslatableResourceTypeFilter             TranslatableResourceType = "FILTER"
+	TranslatableResourceTypeLink               TranslatableResourceType = "LINK"
+	TranslatableResourceTypeMetafield          TranslatableResourceType = "METAFIELD"
+	TranslatableResourceTypeMetaobject         TranslatableResourceType = "METAOBJECT"
+	TranslatableResourceTypeOnlineStoreArticle TranslatableResourceType = "ONLINE_STORE_ARTICLE"
+	TranslatableResourceTypeOnlineStoreBlog    TranslatableResourceType = "ONLINE_STORE_BLOG"
+	TranslatableResourceTypeOnlineStoreMenu    TranslatableResourceType = "ONLINE_STORE_MENU"
+	TranslatableResourceTypeOnlineStorePage    TranslatableResourceType = "ONLINE_STORE_PAGE"
+	TranslatableResourceTypeOnlineStoreTheme   TranslatableResourceType = "ONLINE_STORE_THEME"
+	TranslatableResourceTypePaymentGateway     TranslatableResourceType = "PAYMENT_GATEWAY"
+	TranslatableResourceTypeProduct            TranslatableResourceType = "PRODUCT"
+	TranslatableResourceTypeProductOption      TranslatableResourceType = "PRODUCT_OPTION"
+	TranslatableResourceTypeProductOptionValue TranslatableResourceType = "PRODUCT_OPTION_VALUE"
+	TranslatableResourceTypeSellingPlan        TranslatableResourceType = "SELLING_PLAN"
+	TranslatableResourceTypeSellingPlanGroup   TranslatableResourceType = "SELLING_PLAN_GROUP"
+	TranslatableResourceTypeShop               TranslatableResourceType = "SHOP"
+	TranslatableResourceTypeShopPolicy         TranslatableResourceType = "SHOP_POLICY"
+)
+
+// TranslatableResource represents a resource with its translatable content
+// and, when requested for a locale, the translations of that content
+type TranslatableResource struct {
+	ResourceId          GID                   `json:"resourceId"`
+	TranslatableContent []TranslatableContent `json:"translatableContent"`
+	Translations        []Translation         `json:"translations,omitempty"`
+}
+
+// TranslatableContent represents a field of a resource that can be
+// translated, e.g. the title of a product
+type TranslatableContent struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Digest string `json:"digest"`
+	Locale string `json:"locale"`
+}
+
+// Translation represents the translation of a field of a resource
+type Translation struct {
+	Key       string     `json:"key"`
+	Value     string     `json:"value"`
+	Locale    string     `json:"locale"`
+	Outdated  bool       `json:"outdated,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// TranslationInput represents the input of the translationsRegister
+// mutation, the digest must be the digest of the content being translated
+type TranslationInput struct {
+	Key                       string `json:"key"`
+	Value                     string `json:"value"`
+	Locale                    string `json:"locale"`
+	TranslatableContentDigest string `json:"translatableContentDigest"`
+	MarketId                  *GID   `json:"marketId,omitempty"`
+}
+
+// ShopLocale represents a locale of the shop
+type ShopLocale struct {
+	Locale    string `json:"locale"`
+	Name      string `json:"name"`
+	Primary   bool   `json:"primary"`
+	Published bool   `json:"published"`
+}
+
+// TranslatableResourceListOptions represents the pagination options of a
+// translatable resource list, translations are only returned when a locale
+// is set
+type TranslatableResourceListOptions struct {
+	First   int    `json:"first,omitempty"`
+	After   string `json:"after,omitempty"`
+	Reverse bool   `json:"reverse,omitempty"`
+	Locale  string `json:"locale,omitempty"`
+}
+
+// TranslationDigest returns the digest Shopify computes for translatable
+// content, the hex encoded SHA-256 hash of its value
+func TranslationDigest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// Content returns the translatable content of the resource with the given
+// key, or nil if there is none
+func (r TranslatableResource) Content(key string) *TranslatableContent {
+	for i := range r.TranslatableContent {
+		if r.TranslatableContent[i].Key == key {
+			return &r.TranslatableContent[i]
+		}
+	}
+	return nil
+}
+
+// TranslationInputs builds the inputs to register translations of the
+// resource for a locale from values by content key, each input gets the
+// digest of the content it translates. Inputs are sorted by key.
+func (r TranslatableResource) TranslationInputs(locale string, values map[string]string) ([]TranslationInput, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	inputs := make([]TranslationInput, 0, len(keys))
+	for _, key := range keys {
+		content := r.Content(key)
+		if content == nil {
+			return nil, fmt.Errorf("resource %s has no translatable content %q", r.ResourceId, key)
+		}
+
+		digest := content.Digest
+		if digest == "" {
+			digest = TranslationDigest(content.Value)
+		}
+
+		inputs = append(inputs, TranslationInput{
+			Key:                       key,
+			Value:                     values[key],
+			Locale:                    locale,
+			TranslatableContentDigest: digest,
+		})
+	}
+
+	return inputs, nil
+}
+
+// Untranslated returns the translatable content of the resource without an
+// up to date translation, the resource must have been requested with the
+// locale of the translations
+func (r TranslatableResource) Untranslated() []TranslatableContent {
+	translated := make(map[string]bool, len(r.Translations))
+	for _, translation := range r.Translations {
+		if !translation.Outdated {
+			translated[translation.Key] = true
+		}
+	}
+
+	var untranslated []TranslatableContent
+	for _, content := range r.TranslatableContent {
+		if content.Value != "" && !translated[content.Key] {
+			untranslated = append(untranslated, content)
+		}
+	}
+	return untranslated
+}
+
+// translatableResourceFields returns the fields requested for a
+// translatable resource, translations require the $locale variable
+func translatableResourceFields(withTranslations bool) string {
+	fields := `
+    resourceId
+    translatableContent {
+      key
+      value
+      digest
+      locale
+    }`
+	if withTranslations {
+		fields += `
+    translations(locale: $locale) {
+      key
+      value
+      locale
+      outdated
+      updatedAt
+    }`
+	}
+	return fields + "\n"
+}
+
+func translatableResourcesQuery(withTranslations bool) string {
+	localeVar := ""
+	if withTranslations {
+		localeVar = ", $locale: String!"
+	}
+	return `query translatableResources($resourceType: TranslatableResourceType!, $first: Int!, $after: String, $reverse: Boolean` + localeVar + `) {
+  translatableResources(resourceType: $resourceType, first: $first, after: $after, reverse: $reverse) {
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+    nodes {` + translatableResourceFields(withTranslations) + `}
+  }
+}`
+}
+
+func translatableResourceQuery(withTranslations bool) string {
+	localeVar := ""
+	if withTranslations {
+		localeVar = ", $locale: String!"
+	}
+	return `query translatableResource($resourceId: ID!` + localeVar + `) {
+  translatableResource(resourceId: $resourceId) {` + translatableResourceFields(withTranslations) + `}
+}`
+}
+
+const translationsRegisterMutation = `mutation translationsRegister($resourceId: ID!, $translations: [TranslationInput!]!) {
+  translationsRegister(resourceId: $resourceId, translations: $translations) {
+    translations {
+      key
+      value
+      locale
+      outdated
+      updatedAt
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const translationsRemoveMutation = `mutation translationsRemove($resourceId: ID!, $translationKeys: [String!]!, $locales: [String!]!) {
+  translationsRemove(resourceId: $resourceId, translationKeys: $translationKeys, locales: $locales) {
+    translations {
+      key
+      value
+      locale
+    }
+    userErrors {
+      field
+      message
+      code
+    }
+  }
+}`
+
+const shopLocalesQuery = `query shopLocales {
+  shopLocales {
+    locale
+    name
+    primary
+    published
+  }
+}`
+
+const shopLocaleEnableMutation = `mutation shopLocaleEnable($locale: String!) {
+  shopLocaleEnable(locale: $locale) {
+    shopLocale {
+      locale
+      name
+      primary
+      published
+    }
+    userErrors {
+      field
+      message
+    }
+  }
+}`
+
+// ListResources lists a page of translatable resources of a type, with their
+// translations for options.Locale if set
+func (s *TranslationServiceOp) ListResources(ctx context.Context, resourceType TranslatableResourceType, options *TranslatableResourceListOptions) ([]TranslatableResource, *GraphQLPageInfo, error) {
+	if options == nil {
+		options = &TranslatableResourceListOptions{}
+	}
+
+	first := options.First
+	if first == 0 {
+		first = translatableResourcePageSize
+	}
+
+	vars := map[string]interface{}{"resourceType": resourceType, "first": first}
+	if options.After != "" {
+		vars["after"] = options.After
+	}
+	if options.Reverse {
+		vars["reverse"] = true
+	}
+	if options.Locale != "" {
+		vars["locale"] = options.Locale
+	}
+
+	resp := struct {
+		TranslatableResources struct {
+			PageInfo GraphQLPageInfo        `json:"pageInfo"`
+			Nodes    []TranslatableResource `json:"nodes"`
+		} `json:"translatableResources"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, translatableResourcesQuery(options.Locale != ""), vars, &resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.TranslatableResources.Nodes, &resp.TranslatableResources.PageInfo, nil
+}
+
+// ListAllResources lists all translatable resources of a type, iterating over
+// pages, with their translations for the locale if not empty
+func (s *TranslationServiceOp) ListAllResources(ctx context.Context, resourceType TranslatableResourceType, locale string) ([]TranslatableResource, error) {
+	collector := []TranslatableResource{}
+
+	err := s.EachResource(ctx, resourceType, locale, func(resource TranslatableResource) error {
+		collector = append(collector, resource)
+		return nil
+	})
+
+	return collector, err
+}
+
+// EachResource calls fn for each translatable resource of a type, iterating
+// over pages, with their translations for the locale if not empty. Iteration
+// stops at the first error returned by fn.
+func (s *TranslationServiceOp) EachResource(ctx context.Context, resourceType TranslatableResourceType, locale string, fn func(TranslatableResource) error) error {
+	options := &TranslatableResourceListOptions{Locale: locale}
+
+	for {
+		entities, pageInfo, err := s.ListResources(ctx, resourceType, options)
+		if err != nil {
+			return err
+		}
+
+		for _, entity := range entities {
+			if err := fn(entity); err != nil {
+				return err
+			}
+		}
+
+		if !pageInfo.HasNextPage {
+			break
+		}
+
+		options.After = pageInfo.EndCursor
+	}
+
+	return nil
+}
+
+// GetResource retrieves the translatable content of a resource, with its
+// translations for the locale if not empty
+func (s *TranslationServiceOp) GetResource(ctx context.Context, resourceId GID, locale string) (*TranslatableResource, error) {
+	vars := map[string]interface{}{"resourceId": resourceId}
+	if locale != "" {
+		vars["locale"] = locale
+	}
+
+	resp := struct {
+		TranslatableResource *TranslatableResource `json:"translatableResource"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, translatableResourceQuery(locale != ""), vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.TranslatableResource, nil
+}
+
+// Register creates or updates translations of a resource
+func (s *TranslationServiceOp) Register(ctx context.Context, resourceId GID, translations []TranslationInput) ([]Translation, error) {
+	vars := map[string]interface{}{"resourceId": resourceId, "translations": translations}
+	resp := struct {
+		TranslationsRegister struct {
+			Translations []Translation      `json:"translations"`
+			UserErrors   []GraphQLUserError `json:"userErrors"`
+		} `json:"translationsRegister"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, translationsRegisterMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.TranslationsRegister.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.TranslationsRegister.Translations, nil
+}
+
+// Remove deletes the translations of a resource for the given content keys
+// and locales
+func (s *TranslationServiceOp) Remove(ctx context.Context, resourceId GID, translationKeys []string, locales []string) ([]Translation, error) {
+	vars := map[string]interface{}{"resourceId": resourceId, "translationKeys": translationKeys, "locales": locales}
+	resp := struct {
+		TranslationsRemove struct {
+			Translations []Translation      `json:"translations"`
+			UserErrors   []GraphQLUserError `json:"userErrors"`
+		} `json:"translationsRemove"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, translationsRemoveMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.TranslationsRemove.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.TranslationsRemove.Translations, nil
+}
+
+// ListLocales lists the locales enabled on the shop
+func (s *TranslationServiceOp) ListLocales(ctx context.Context) ([]ShopLocale, error) {
+	resp := struct {
+		ShopLocales []ShopLocale `json:"shopLocales"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, shopLocalesQuery, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ShopLocales, nil
+}
+
+// EnableLocale enables a locale on the shop, the locale stays unpublished
+// until it is published in the admin
+func (s *TranslationServiceOp) EnableLocale(ctx context.Context, locale string) (*ShopLocale, error) {
+	vars := map[string]interface{}{"locale": locale}
+	resp := struct {
+		ShopLocaleEnable struct {
+			ShopLocale *ShopLocale        `json:"shopLocale"`
+			UserErrors []GraphQLUserError `json:"userErrors"`
+		} `json:"shopLocaleEnable"`
+	}{}
+
+	err := s.client.GraphQL.Query(ctx, shopLocaleEnableMutation, vars, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := userErrorsToError(resp.ShopLocaleEnable.UserErrors); err != nil {
+		return nil, err
+	}
+
+	return resp.ShopLocaleEnable.ShopLocale, nil
+}
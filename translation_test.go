@@ -0,0 +1,321 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestTranslationDigest(t *testing.T) {
+	digest := TranslationDigest("abc")
+	expected := "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if digest != expected {
+		t.Errorf("TranslationDigest returned %s, expected %s", digest, expected)
+	}
+}
+
+func TestTranslatableResourceTranslationInputs(t *testing.T) {
+	resource := TranslatableResource{
+		ResourceId: NewGID(GIDResourceProduct, 1),
+		TranslatableContent: []TranslatableContent{
+			{Key: "title", Value: "Shirt", Digest: "d1", Locale: "en"},
+			{Key: "body_html", Value: "<p>Cotton</p>", Locale: "en"},
+		},
+	}
+
+	inputs, err := resource.TranslationInputs("fr", map[string]string{"title": "Chemise", "body_html": "<p>Coton</p>"})
+	if err != nil {
+		t.Fatalf("TranslatableResource.TranslationInputs returned error: %v", err)
+	}
+
+	expected := []TranslationInput{
+		{Key: "body_html", Value: "<p>Coton</p>", Locale: "fr", TranslatableContentDigest: TranslationDigest("<p>Cotton</p>")},
+		{Key: "title", Value: "Chemise", Locale: "fr", TranslatableContentDigest: "d1"},
+	}
+	if !reflect.DeepEqual(inputs, expected) {
+		t.Errorf("TranslatableResource.TranslationInputs returned %+v, expected %+v", inputs, expected)
+	}
+
+	_, err = resource.TranslationInputs("fr", map[string]string{"handle": "chemise"})
+	expectedErr := `resource gid://shopify/Product/1 has no translatable content "handle"`
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("TranslatableResource.TranslationInputs returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestTranslatableResourceUntranslated(t *testing.T) {
+	resource := TranslatableResource{
+		TranslatableContent: []TranslatableContent{
+			{Key: "title", Value: "Shirt"},
+			{Key: "body_html", Value: "<p>Cotton</p>"},
+			{Key: "meta_title", Value: "Shirt | Shop"},
+			{Key: "meta_description", Value: ""},
+		},
+		Translations: []Translation{
+			{Key: "title", Value: "Chemise", Locale: "fr"},
+			{Key: "body_html", Value: "<p>Coton</p>", Locale: "fr", Outdated: true},
+		},
+	}
+
+	expected := []TranslatableContent{
+		{Key: "body_html", Value: "<p>Cotton</p>"},
+		{Key: "meta_title", Value: "Shirt | Shop"},
+	}
+	if untranslated := resource.Untranslated(); !reflect.DeepEqual(untranslated, expected) {
+		t.Errorf("TranslatableResource.Untranslated returned %+v, expected %+v", untranslated, expected)
+	}
+}
+
+func TestTranslationListResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var query string
+	var vars map[string]string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			q, v := graphQLRequestBody(req)
+			query = q
+			vars = map[string]string{}
+			for k, raw := range v {
+				vars[k] = string(raw)
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"translatableResources":{
+				"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+				"nodes":[{
+					"resourceId":"gid://shopify/Collection/1",
+					"translatableContent":[{"key":"title","value":"Summer","digest":"d1","locale":"en"}]
+				}]
+			}}}`), nil
+		},
+	)
+
+	resources, pageInfo, err := client.Translation.ListResources(context.Background(), TranslatableResourceTypeCollection, &TranslatableResourceListOptions{First: 10})
+	if err != nil {
+		t.Fatalf("Translation.ListResources returned error: %v", err)
+	}
+
+	expected := []TranslatableResource{{
+		ResourceId:          NewGID(GIDResourceCollection, 1),
+		TranslatableContent: []TranslatableContent{{Key: "title", Value: "Summer", Digest: "d1", Locale: "en"}},
+	}}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Translation.ListResources returned %+v, expected %+v", resources, expected)
+	}
+	if !pageInfo.HasNextPage || pageInfo.EndCursor != "abc" {
+		t.Errorf("Translation.ListResources returned page info %+v", pageInfo)
+	}
+
+	expectedVars := map[string]string{"resourceType": `"COLLECTION"`, "first": "10"}
+	if !reflect.DeepEqual(vars, expectedVars) {
+		t.Errorf("Translation.ListResources sent %+v, expected %+v", vars, expectedVars)
+	}
+	if strings.Contains(query, "translations(") || strings.Contains(query, "$locale") {
+		t.Errorf("Translation.ListResources requested translations without a locale: %s", query)
+	}
+}
+
+func TestTranslationEachResource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var cursors []string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query, vars := graphQLRequestBody(req)
+			if !strings.Contains(query, "translations(locale: $locale)") || string(vars["locale"]) != `"fr"` {
+				return httpmock.NewStringResponse(400, `{"errors":"expected translations for fr"}`), nil
+			}
+			cursors = append(cursors, string(vars["after"]))
+			if vars["after"] == nil {
+				return httpmock.NewStringResponse(200, `{"data":{"translatableResources":{
+					"pageInfo":{"hasNextPage":true,"endCursor":"abc"},
+					"nodes":[{"resourceId":"gid://shopify/Product/1","translatableContent":[],"translations":[]}]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"translatableResources":{
+				"pageInfo":{"hasNextPage":false},
+				"nodes":[
+					{"resourceId":"gid://shopify/Product/2","translatableContent":[],"translations":[{"key":"title","value":"Chemise","locale":"fr"}]},
+					{"resourceId":"gid://shopify/Product/3","translatableContent":[],"translations":[]}
+				]
+			}}}`), nil
+		},
+	)
+
+	resources, err := client.Translation.ListAllResources(context.Background(), TranslatableResourceTypeProduct, "fr")
+	if err != nil {
+		t.Fatalf("Translation.ListAllResources returned error: %v", err)
+	}
+	if len(resources) != 3 || resources[1].Translations[0].Value != "Chemise" {
+		t.Errorf("Translation.ListAllResources returned %+v", resources)
+	}
+
+	expectedCursors := []string{"", `"abc"`}
+	if !reflect.DeepEqual(cursors, expectedCursors) {
+		t.Errorf("Translation.ListAllResources requested cursors %+v, expected %+v", cursors, expectedCursors)
+	}
+
+	stop := errors.New("stop")
+	visited := 0
+	err = client.Translation.EachResource(context.Background(), TranslatableResourceTypeProduct, "fr", func(resource TranslatableResource) error {
+		visited++
+		if resource.ResourceId.Id == 2 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("Translation.EachResource returned error %v, expected %v", err, stop)
+	}
+	if visited != 2 {
+		t.Errorf("Translation.EachResource visited %d resources, expected 2", visited)
+	}
+}
+
+func TestTranslationGetResource(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var vars map[string]string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, v := graphQLRequestBody(req)
+			vars = map[string]string{"resourceId": string(v["resourceId"]), "locale": string(v["locale"])}
+			return httpmock.NewStringResponse(200, `{"data":{"translatableResource":{
+				"resourceId":"gid://shopify/OnlineStorePage/1",
+				"translatableContent":[{"key":"title","value":"About","digest":"d1","locale":"en"}],
+				"translations":[{"key":"title","value":"Über uns","locale":"de","outdated":true,"updatedAt":"2024-01-02T09:00:00Z"}]
+			}}}`), nil
+		},
+	)
+
+	resource, err := client.Translation.GetResource(context.Background(), NewGID(GIDResourcePage, 1), "de")
+	if err != nil {
+		t.Fatalf("Translation.GetResource returned error: %v", err)
+	}
+
+	expectedVars := map[string]string{"resourceId": `"gid://shopify/OnlineStorePage/1"`, "locale": `"de"`}
+	if !reflect.DeepEqual(vars, expectedVars) {
+		t.Errorf("Translation.GetResource sent %+v, expected %+v", vars, expectedVars)
+	}
+
+	if len(resource.Translations) != 1 || !resource.Translations[0].Outdated || resource.Translations[0].UpdatedAt == nil {
+		t.Errorf("Translation.GetResource returned %+v", resource)
+	}
+}
+
+func TestTranslationRegister(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var translations string
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			_, vars := graphQLRequestBody(req)
+			translations = string(vars["translations"])
+			return httpmock.NewStringResponse(200, `{"data":{"translationsRegister":{
+				"translations":[{"key":"title","value":"Chemise","locale":"fr","outdated":false}],
+				"userErrors":[]
+			}}}`), nil
+		},
+	)
+
+	result, err := client.Translation.Register(context.Background(), NewGID(GIDResourceProduct, 1), []TranslationInput{
+		{Key: "title", Value: "Chemise", Locale: "fr", TranslatableContentDigest: "d1"},
+	})
+	if err != nil {
+		t.Fatalf("Translation.Register returned error: %v", err)
+	}
+
+	expectedTranslations := `[{"key":"title","value":"Chemise","locale":"fr","translatableContentDigest":"d1"}]`
+	if translations != expectedTranslations {
+		t.Errorf("Translation.Register sent %s, expected %s", translations, expectedTranslations)
+	}
+
+	expected := []Translation{{Key: "title", Value: "Chemise", Locale: "fr"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Translation.Register returned %+v, expected %+v", result, expected)
+	}
+}
+
+func TestTranslationRemoveUserErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"translationsRemove":{
+			"translations":null,
+			"userErrors":[{"field":["locales"],"message":"Locale is not enabled","code":"INVALID_LOCALE_FOR_SHOP"}]
+		}}}`),
+	)
+
+	_, err := client.Translation.Remove(context.Background(), NewGID(GIDResourceProduct, 1), []string{"title"}, []string{"xx"})
+
+	expectedErr := "locales: Locale is not enabled"
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Translation.Remove returned error %v, expected %s", err, expectedErr)
+	}
+}
+
+func TestTranslationLocales(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			query, _ := graphQLRequestBody(req)
+			if GraphQLOperationName(query) == "shopLocaleEnable" {
+				return httpmock.NewStringResponse(200, `{"data":{"shopLocaleEnable":{
+					"shopLocale":{"locale":"de","name":"German","primary":false,"published":false},
+					"userErrors":[]
+				}}}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"data":{"shopLocales":[
+				{"locale":"en","name":"English","primary":true,"published":true},
+				{"locale":"fr","name":"French","primary":false,"published":true}
+			]}}`), nil
+		},
+	)
+
+	locales, err := client.Translation.ListLocales(context.Background())
+	if err != nil {
+		t.Fatalf("Translation.ListLocales returned error: %v", err)
+	}
+
+	expected := []ShopLocale{
+		{Locale: "en", Name: "English", Primary: true, Published: true},
+		{Locale: "fr", Name: "French", Published: true},
+	}
+	if !reflect.DeepEqual(locales, expected) {
+		t.Errorf("Translation.ListLocales returned %+v, expected %+v", locales, expected)
+	}
+
+	locale, err := client.Translation.EnableLocale(context.Background(), "de")
+	if err != nil {
+		t.Fatalf("Translation.EnableLocale returned error: %v", err)
+	}
+
+	expectedLocale := &ShopLocale{Locale: "de", Name: "German"}
+	if !reflect.DeepEqual(locale, expectedLocale) {
+		t.Errorf("Translation.EnableLocale returned %+v, expected %+v", locale, expectedLocale)
+	}
+}
@@ -49,6 +49,15 @@ func FulfillmentPathPrefix(resource string, resourceId uint64) string {
 	return prefix
 }
 
+// Return the prefix for an event path
+func EventPathPrefix(resource string, resourceId uint64) string {
+	prefix := "events"
+	if resource != "" {
+		prefix = fmt.Sprintf("%s/%d/events", resource, resourceId)
+	}
+	return prefix
+}
+
 type OnlyDate struct {
 	time.Time
 }
@@ -103,6 +103,24 @@ func TestFulfillmentPathPrefix(t *testing.T) {
 	}
 }
 
+func TestEventPathPrefix(t *testing.T) {
+	cases := []struct {
+		resource   string
+		resourceId uint64
+		expected   string
+	}{
+		{"", 0, "events"},
+		{"products", 123, "products/123/events"},
+	}
+
+	for _, c := range cases {
+		actual := EventPathPrefix(c.resource, c.resourceId)
+		if actual != c.expected {
+			t.Errorf("EventPathPrefix(%s, %d): expected %s, actual %s", c.resource, c.resourceId, c.expected, actual)
+		}
+	}
+}
+
 func TestOnlyDateMarshal(t *testing.T) {
 	cases := []struct {
 		in       OnlyDate